@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"backend/internal/audit"
 	"backend/internal/infrastructure/nats"
 	"backend/internal/storage"
 	"context"
@@ -15,10 +16,22 @@ var NatsModule = fx.Module(
 	fx.Provide(storage.NewStorageProducer),
 	fx.Provide(storage.NewFileUploadedConsumer),
 	fx.Provide(storage.NewFilesDeletedConsumer),
+	fx.Provide(storage.NewFileClassifiedConsumer),
+	fx.Provide(storage.NewFileSummarizedConsumer),
 	fx.Provide(storage.NewOutboxProcessor),
+	fx.Provide(storage.NewTrashPurger),
+	fx.Provide(storage.NewGarbageCollector),
+	fx.Provide(audit.NewAuditProducer),
+	fx.Provide(audit.NewAuditEventRecordedConsumer),
+	fx.Provide(audit.NewOutboxProcessor),
 	fx.Invoke(CreateStorageChannel),
 	fx.Invoke(SubcribeStorageConsumers),
 	fx.Invoke(RunOutboxProcessor),
+	fx.Invoke(RunTrashPurger),
+	fx.Invoke(RunGarbageCollector),
+	fx.Invoke(CreateAuditChannel),
+	fx.Invoke(SubcribeAuditConsumers),
+	fx.Invoke(RunAuditOutboxProcessor),
 )
 
 func CreateStorageChannel(
@@ -36,6 +49,8 @@ func SubcribeStorageConsumers(
 	lc fx.Lifecycle,
 	fileUploadedConsumer *storage.FileUploadedConsumer,
 	filesDeletedConsumer *storage.FilesDeletedConsumer,
+	fileClassifiedConsumer *storage.FileClassifiedConsumer,
+	fileSummarizedConsumer *storage.FileSummarizedConsumer,
 ) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -45,11 +60,19 @@ func SubcribeStorageConsumers(
 			if err := filesDeletedConsumer.Subscribe(ctx); err != nil {
 				return err
 			}
+			if err := fileClassifiedConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
+			if err := fileSummarizedConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
 			fileUploadedConsumer.Stop()
 			filesDeletedConsumer.Stop()
+			fileClassifiedConsumer.Stop()
+			fileSummarizedConsumer.Stop()
 			return nil
 		},
 	})
@@ -73,3 +96,86 @@ func RunOutboxProcessor(
 		},
 	})
 }
+
+func RunTrashPurger(
+	lc fx.Lifecycle,
+	purger *storage.TrashPurger,
+) {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go purger.Start(ctx)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+func RunGarbageCollector(
+	lc fx.Lifecycle,
+	collector *storage.GarbageCollector,
+) {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go collector.Start(ctx)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+func CreateAuditChannel(
+	lc fx.Lifecycle,
+	audit *audit.AuditProducer,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return audit.CreateChannel(ctx)
+		},
+	})
+}
+
+func SubcribeAuditConsumers(
+	lc fx.Lifecycle,
+	auditEventRecordedConsumer *audit.AuditEventRecordedConsumer,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return auditEventRecordedConsumer.Subscribe(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			auditEventRecordedConsumer.Stop()
+			return nil
+		},
+	})
+}
+
+func RunAuditOutboxProcessor(
+	lc fx.Lifecycle,
+	processor *audit.OutboxProcessor,
+) {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go processor.Start(ctx)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	auditdb "backend/internal/audit/db"
 	"backend/internal/infrastructure/config"
 	"backend/internal/infrastructure/postgres"
 	ocrdb "backend/internal/ocr/db"
@@ -25,7 +26,11 @@ var PostgresModule = fx.Module(
 	fx.Provide(func(pool *pgxpool.Pool) *ocrdb.Queries {
 		return ocrdb.New(pool)
 	}),
+	fx.Provide(func(pool *pgxpool.Pool) *auditdb.Queries {
+		return auditdb.New(pool)
+	}),
 	fx.Invoke(RunStorageMigrations),
+	fx.Invoke(RunAuditMigrations),
 )
 
 func RunStorageMigrations(
@@ -57,3 +62,33 @@ func RunStorageMigrations(
 		},
 	})
 }
+
+func RunAuditMigrations(
+	lc fx.Lifecycle,
+	cfg *config.AppConfig,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			source, err := iofs.New(migrations.MigrationsFS, "audit")
+			if err != nil {
+				return err
+			}
+
+			m, err := migrate.NewWithSourceInstance(
+				"iofs",
+				source,
+				fmt.Sprintf("%s?x-migrations-table=audit_schema_migrations", cfg.Postgres.Dsn),
+			)
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+				return err
+			}
+
+			return nil
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/server"
 	"backend/internal/infrastructure/service"
 	"context"
@@ -12,6 +13,8 @@ import (
 
 var ServerModule = fx.Module(
 	"server",
+	fx.Provide(auth.NewAuthenticators),
+	fx.Provide(auth.NewMiddleware),
 	fx.Provide(server.NewGatewayServeMux),
 	fx.Provide(server.NewServeMux),
 	fx.Provide(server.NewHttpServer),
@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"backend/internal/audit"
 	"backend/internal/health"
 	"backend/internal/infrastructure/service"
 	"backend/internal/ocr"
@@ -21,8 +22,16 @@ var ServicesModule = fx.Module(
 		return storage
 	})),
 	fx.Provide(service.AsService(storage.NewFilesService)),
+	fx.Provide(service.AsService(storage.NewFoldersService)),
+	fx.Provide(service.AsService(storage.NewFileEventsService)),
 	// Ocr
 	fx.Provide(service.AsService(ocr.NewFilesService)),
+	fx.Provide(service.AsService(ocr.NewExtractionSchemaService)),
+	fx.Provide(ocr.NewProgressReader),
+	fx.Provide(ocr.NewSchemaLookup),
+	// Audit
+	fx.Provide(audit.NewOutboxRecorder),
+	fx.Provide(service.AsService(audit.NewAuditService)),
 	// Register services
 	fx.Provide(service.AsRegister(service.RegisterServices)),
 	// Create buckets on startup
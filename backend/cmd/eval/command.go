@@ -0,0 +1,161 @@
+package eval
+
+import (
+	"backend/internal/eval"
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/llm"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/spf13/cobra"
+)
+
+var EvalCmd = &cobra.Command{
+	Use:   "eval",
+	Short: "Evaluate OCR agent configurations against a ground-truth dataset",
+	Long: `Runs every page image of a dataset directory through the OCR agent of
+each given prompts config and reports character and word error rates against
+the ground-truth text stored next to each image (page.png + page.txt).
+
+Modes:
+  live      call the provider, recording responses with --recordings
+  replay    serve responses from the LLM_CACHE KV bucket, no provider calls;
+            prompts must match a version of the LLM_PROMPTS bucket to hit it
+  recorded  serve responses from --recordings, no network access at all`,
+	RunE: run,
+}
+
+var (
+	dataset    string
+	configs    []string
+	mode       string
+	recordings string
+	format     string
+	output     string
+)
+
+func init() {
+	EvalCmd.Flags().StringVarP(&dataset, "dataset", "d", "", "directory with page images and ground-truth text")
+	EvalCmd.Flags().StringSliceVarP(&configs, "config", "c", []string{"prompts.yaml"}, "prompts config to evaluate, repeat to compare")
+	EvalCmd.Flags().StringVarP(&mode, "mode", "m", eval.ModeLive, "live, replay or recorded")
+	EvalCmd.Flags().StringVar(&recordings, "recordings", "", "directory to record responses to or read them from")
+	EvalCmd.Flags().StringVarP(&format, "format", "f", "markdown", "report format: markdown or json")
+	EvalCmd.Flags().StringVarP(&output, "output", "o", "", "report file, defaults to stdout")
+	EvalCmd.MarkFlagRequired("dataset")
+}
+
+func run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if format != "markdown" && format != "json" {
+		return fmt.Errorf("unknown report format: %s", format)
+	}
+
+	samples, err := eval.LoadDataset(dataset)
+	if err != nil {
+		return err
+	}
+
+	runner, closeRunner, err := newRunner(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeRunner()
+
+	report := &eval.Report{
+		Dataset:   dataset,
+		Mode:      runner.Mode(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for _, path := range configs {
+		cfg, err := llm.ReadLlmConfig(path)
+		if err != nil {
+			return err
+		}
+
+		result, err := runner.Run(ctx, eval.Config{
+			Name: strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+			Llm:  cfg,
+		}, samples)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.ErrOrStderr(), result)
+		report.Configs = append(report.Configs, result)
+	}
+
+	var w io.Writer = cmd.OutOrStdout()
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if format == "json" {
+		return report.WriteJSON(w)
+	}
+	return report.WriteMarkdown(w)
+}
+
+func newRunner(ctx context.Context) (*eval.Runner, func(), error) {
+	switch mode {
+	case eval.ModeRecorded:
+		if recordings == "" {
+			return nil, nil, fmt.Errorf("--recordings is required in recorded mode")
+		}
+		return eval.NewRecordedRunner(recordings), func() {}, nil
+	case eval.ModeReplay:
+		cfg, err := config.LoadAppConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nc, err := nats.Connect(cfg.Nats.Uri)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		js, err := jetstream.New(nc)
+		if err != nil {
+			nc.Close()
+			return nil, nil, err
+		}
+
+		kv, err := js.KeyValue(ctx, "LLM_CACHE")
+		if err != nil {
+			nc.Close()
+			return nil, nil, err
+		}
+
+		prompts, err := js.KeyValue(ctx, "LLM_PROMPTS")
+		if err != nil {
+			nc.Close()
+			return nil, nil, err
+		}
+
+		return eval.NewReplayRunner(kv, prompts), nc.Close, nil
+	case eval.ModeLive:
+		cfg, err := config.LoadAppConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		return eval.NewLiveRunner(llm.NewClient(cfg), recordings), func() {}, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown mode: %s", mode)
+}
@@ -0,0 +1,96 @@
+package gc
+
+import (
+	"backend/internal/infrastructure/config"
+	stg "backend/internal/infrastructure/storage"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/storage"
+	storagedb "backend/internal/storage/db"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/cobra"
+)
+
+var GcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Collect files whose objects, pages or rows are orphaned",
+	Long: `Cross-checks the files bucket listing and ocr.file_pages against
+storage.files and reports the files that only have objects or pages left, and
+the rows whose source object is missing, ignoring anything newer than the
+grace period. Nothing is deleted unless --delete is given, in which case the
+reported rows are deleted and a files deleted event is emitted for every
+reported file so each domain cleans it up.`,
+	RunE: run,
+}
+
+var (
+	remove      bool
+	gracePeriod time.Duration
+)
+
+func init() {
+	GcCmd.Flags().BoolVar(&remove, "delete", false, "delete the reported files instead of a dry run")
+	GcCmd.Flags().DurationVar(&gracePeriod, "grace-period", 0, "skip files with objects or pages newer than this, defaults to gc.grace_period")
+}
+
+func run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cfg, err := config.LoadAppConfig()
+	if err != nil {
+		return err
+	}
+	if gracePeriod > 0 {
+		cfg.GC.GracePeriod = gracePeriod
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.Postgres.Uri)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	client, err := stg.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	collector := storage.NewGarbageCollector(
+		cfg,
+		pool,
+		storagedb.New(pool),
+		ocrdb.New(pool),
+		client,
+	)
+
+	report, err := collector.Collect(ctx, !remove)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	fmt.Fprintf(w, "Garbage files: %d\n", len(report.Files))
+	for _, file := range report.Files {
+		if file.Missing {
+			fmt.Fprintf(w, "  %s/%s missing source object\n", file.TenantID, file.FileKey)
+			continue
+		}
+		fmt.Fprintf(w, "  %s/%s objects=%d pages=%d\n", file.TenantID, file.FileKey, file.Objects, file.Pages)
+	}
+
+	if report.DryRun {
+		if len(report.Files) > 0 {
+			fmt.Fprintln(w, "Dry run, run with --delete to delete them")
+		}
+	} else {
+		fmt.Fprintln(w, "Deletion emitted")
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"backend/cmd/api"
+	"backend/cmd/eval"
+	"backend/cmd/gc"
 	"backend/cmd/ocr"
 	ocrllm "backend/cmd/ocr-llm"
 	"backend/cmd/telegram"
@@ -16,6 +18,8 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(api.ApiCmd)
+	rootCmd.AddCommand(eval.EvalCmd)
+	rootCmd.AddCommand(gc.GcCmd)
 	rootCmd.AddCommand(ocr.OcrCmd)
 	rootCmd.AddCommand(ocrllm.OcrLlmCmd)
 	rootCmd.AddCommand(telegram.TelegramCmd)
@@ -14,6 +14,7 @@ var NatsModule = fx.Module(
 	fx.Provide(nats.NewNatsClient),
 	fx.Provide(nats.NewJetStreamClient),
 	fx.Provide(ocr.NewOcrProducer),
+	fx.Provide(ocrimage.NewLayoutExtractor),
 	fx.Provide(ocrimage.NewFileUploadedConsumer),
 	fx.Invoke(SubscribeOcrImageConsumers),
 )
@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"backend/internal/infrastructure/llm"
 	ocrllm "backend/internal/ocr-llm"
+	"context"
 
 	"go.uber.org/fx"
 )
@@ -10,5 +11,31 @@ import (
 var LlmModule = fx.Module(
 	"llm",
 	fx.Provide(llm.NewClient),
+	fx.Provide(llm.NewUsageMeter),
+	fx.Provide(llm.NewLimiter),
+	fx.Provide(llm.NewPromptRegistry),
+	fx.Provide(ocrllm.NewBudgetGuard),
+	fx.Provide(ocrllm.NewPageImageLoader),
 	fx.Provide(ocrllm.NewOcrAgent),
+	fx.Provide(ocrllm.NewExtractionAgent),
+	fx.Provide(ocrllm.NewClassifierAgent),
+	fx.Provide(ocrllm.NewTranslationAgent),
+	fx.Provide(ocrllm.NewSummaryAgent),
+	fx.Provide(ocrllm.NewRedactionAgent),
+	fx.Provide(ocrllm.NewRedactor),
+	fx.Invoke(WatchPrompts),
 )
+
+func WatchPrompts(
+	lc fx.Lifecycle,
+	prompts *llm.PromptRegistry,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return prompts.Start(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			return prompts.Stop()
+		},
+	})
+}
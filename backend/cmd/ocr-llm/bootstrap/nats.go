@@ -14,6 +14,11 @@ var NatsModule = fx.Module(
 	fx.Provide(nats.NewNatsClient),
 	fx.Provide(nats.NewJetStreamClient),
 	fx.Provide(ocrllm.NewFilePageRegisteredConsumer),
+	fx.Provide(ocrllm.NewFilePageOcrGeneratedConsumer),
+	fx.Provide(ocrllm.NewFileClassifierConsumer),
+	fx.Provide(ocrllm.NewFilePageTranslationConsumer),
+	fx.Provide(ocrllm.NewFileSummarizerConsumer),
+	fx.Provide(ocrllm.NewFilePageRedactionConsumer),
 	fx.Provide(llm.NewLlmCache),
 	fx.Invoke(SubcribeOcrLlmConsumers),
 )
@@ -21,17 +26,42 @@ var NatsModule = fx.Module(
 func SubcribeOcrLlmConsumers(
 	lc fx.Lifecycle,
 	filePageRegisteredConsumer *ocrllm.FilePageRegisteredConsumer,
+	filePageOcrGeneratedConsumer *ocrllm.FilePageOcrGeneratedConsumer,
+	fileClassifierConsumer *ocrllm.FileClassifierConsumer,
+	filePageTranslationConsumer *ocrllm.FilePageTranslationConsumer,
+	fileSummarizerConsumer *ocrllm.FileSummarizerConsumer,
+	filePageRedactionConsumer *ocrllm.FilePageRedactionConsumer,
 ) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			if err := filePageRegisteredConsumer.Subscribe(ctx); err != nil {
 				return err
 			}
+			if err := filePageOcrGeneratedConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
+			if err := fileClassifierConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
+			if err := filePageTranslationConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
+			if err := fileSummarizerConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
+			if err := filePageRedactionConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
 
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
 			filePageRegisteredConsumer.Stop()
+			filePageOcrGeneratedConsumer.Stop()
+			fileClassifierConsumer.Stop()
+			filePageTranslationConsumer.Stop()
+			fileSummarizerConsumer.Stop()
+			filePageRedactionConsumer.Stop()
 			return nil
 		},
 	})
@@ -13,6 +13,8 @@ var ServicesModule = fx.Module(
 	// Provide services
 	fx.Provide(service.AsService(health.NewHealthService)),
 	fx.Provide(service.AsService(ocrllm.NewLlmDebugService)),
+	fx.Provide(service.AsService(ocrllm.NewPromptsService)),
+	fx.Provide(service.AsService(ocrllm.NewLlmCacheService)),
 	// Register services
 	fx.Provide(service.AsRegister(service.RegisterServices)),
 )
@@ -16,6 +16,8 @@ var NatsModule = fx.Module(
 	fx.Provide(ocr.NewFilePageRenderedConsumer),
 	fx.Provide(ocr.NewFilesDeletedConsumer),
 	fx.Provide(ocr.NewFilePagesDeletedConsumer),
+	fx.Provide(ocr.NewFilesTrashedConsumer),
+	fx.Provide(ocr.NewFilesRestoredConsumer),
 	fx.Provide(ocr.NewOutboxProcessor),
 	fx.Invoke(CreateOcrChannel),
 	fx.Invoke(SubcribeOcrConsumers),
@@ -38,6 +40,8 @@ func SubcribeOcrConsumers(
 	filePageRenderedConsumer *ocr.FilePageRenderedConsumer,
 	filesDeletedConsumer *ocr.FilesDeletedConsumer,
 	filePagesDeletedConsumer *ocr.FilePagesDeletedConsumer,
+	filesTrashedConsumer *ocr.FilesTrashedConsumer,
+	filesRestoredConsumer *ocr.FilesRestoredConsumer,
 ) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -50,12 +54,20 @@ func SubcribeOcrConsumers(
 			if err := filePagesDeletedConsumer.Subscribe(ctx); err != nil {
 				return err
 			}
+			if err := filesTrashedConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
+			if err := filesRestoredConsumer.Subscribe(ctx); err != nil {
+				return err
+			}
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
 			filePageRenderedConsumer.Stop()
 			filesDeletedConsumer.Stop()
 			filePagesDeletedConsumer.Stop()
+			filesTrashedConsumer.Stop()
+			filesRestoredConsumer.Stop()
 			return nil
 		},
 	})
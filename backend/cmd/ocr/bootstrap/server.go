@@ -1,6 +1,8 @@
 package bootstrap
 
 import (
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/server"
 	"backend/internal/infrastructure/service"
 	"context"
@@ -12,6 +14,9 @@ import (
 
 var ServerModule = fx.Module(
 	"server",
+	fx.Provide(audit.NewNopRecorder),
+	fx.Provide(auth.NewAuthenticators),
+	fx.Provide(auth.NewMiddleware),
 	fx.Provide(server.NewGatewayServeMux),
 	fx.Provide(http.NewServeMux),
 	fx.Provide(server.NewHttpServer),
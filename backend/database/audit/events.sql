@@ -0,0 +1,22 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit.events (
+    id, tenant_id, actor, auth_method, action,
+    resource_type, resource_id, trace_id, client_ip, occurred_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: GetAuditEvents :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total
+FROM audit.events
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND (sqlc.narg('actor')::text IS NULL OR actor = sqlc.narg('actor'))
+  AND (sqlc.narg('action')::text IS NULL OR action = sqlc.narg('action'))
+  AND (sqlc.narg('resource_type')::text IS NULL OR resource_type = sqlc.narg('resource_type'))
+  AND (sqlc.narg('resource_id')::text IS NULL OR resource_id = sqlc.narg('resource_id'))
+  AND (sqlc.narg('from')::timestamptz IS NULL OR occurred_at >= sqlc.narg('from'))
+  AND (sqlc.narg('to')::timestamptz IS NULL OR occurred_at <= sqlc.narg('to'))
+ORDER BY occurred_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
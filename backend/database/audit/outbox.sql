@@ -0,0 +1,16 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO audit.outbox (event_id, event_type, payload)
+VALUES ($1, $2, $3);
+
+-- name: GetOutboxUnpublishedEvents :many
+SELECT event_id, event_type, payload, created_at
+FROM audit.outbox
+WHERE published_at IS NULL
+ORDER BY created_at
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkEventAsPublished :exec
+UPDATE audit.outbox
+SET published_at = NOW()
+WHERE event_id = $1;
\ No newline at end of file
@@ -0,0 +1,52 @@
+-- name: CreateExtractionSchema :one
+INSERT INTO ocr.extraction_schemas (id, name, description, json_schema, tenant_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetExtractionSchemas :many
+SELECT 
+    *,
+    COUNT(*) OVER() AS total
+FROM ocr.extraction_schemas
+WHERE tenant_id = $1
+ORDER BY name ASC
+LIMIT $2 OFFSET $3;
+
+-- name: GetExtractionSchemaByID :one
+SELECT *
+FROM ocr.extraction_schemas
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: UpdateExtractionSchema :one
+UPDATE ocr.extraction_schemas
+SET name = $2,
+    description = $3,
+    json_schema = $4,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $5
+RETURNING *;
+
+-- name: DeleteExtractionSchema :exec
+DELETE FROM ocr.extraction_schemas
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: UpsertPageExtraction :exec
+INSERT INTO ocr.page_extractions (page_id, schema_id, file_id, data, error_message)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (page_id, schema_id) DO UPDATE
+SET data = EXCLUDED.data,
+    error_message = EXCLUDED.error_message,
+    updated_at = NOW();
+
+-- name: GetPageExtractionsByFileID :many
+SELECT 
+    e.page_id,
+    e.schema_id,
+    e.data,
+    e.error_message,
+    e.created_at,
+    p.page_number
+FROM ocr.page_extractions e
+JOIN ocr.file_pages p ON p.id = e.page_id
+WHERE e.file_id = $1
+ORDER BY p.page_number ASC;
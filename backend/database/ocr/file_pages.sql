@@ -1,15 +1,65 @@
 -- name: CreateFilePage :exec
-INSERT INTO ocr.file_pages (id, file_id, page_image_key, page_number)
-VALUES ($1, $2, $3, $4);
+INSERT INTO ocr.file_pages (id, file_id, page_image_key, page_number, content_hash, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetFilePagesByFileID :many
-SELECT 
-    *,
-    COUNT(*) OVER() AS total
-FROM ocr.file_pages
-WHERE file_id = $1
+SELECT * FROM ocr.file_pages
+WHERE file_id = sqlc.arg('file_id') AND tenant_id = sqlc.arg('tenant_id')
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND (sqlc.narg('from_page')::int IS NULL OR page_number >= sqlc.narg('from_page'))
+  AND (sqlc.narg('to_page')::int IS NULL OR page_number <= sqlc.narg('to_page'))
+  AND (sqlc.narg('after_page')::int IS NULL OR page_number > sqlc.narg('after_page'))
 ORDER BY page_number ASC
-LIMIT $2 OFFSET $3;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetFilePagesByFileIDDesc :many
+SELECT * FROM ocr.file_pages
+WHERE file_id = sqlc.arg('file_id') AND tenant_id = sqlc.arg('tenant_id')
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND (sqlc.narg('from_page')::int IS NULL OR page_number >= sqlc.narg('from_page'))
+  AND (sqlc.narg('to_page')::int IS NULL OR page_number <= sqlc.narg('to_page'))
+  AND (sqlc.narg('after_page')::int IS NULL OR page_number < sqlc.narg('after_page'))
+ORDER BY page_number DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountFilePagesByFileID :one
+SELECT COUNT(*) FROM ocr.file_pages
+WHERE file_id = sqlc.arg('file_id') AND tenant_id = sqlc.arg('tenant_id')
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND (sqlc.narg('from_page')::int IS NULL OR page_number >= sqlc.narg('from_page'))
+  AND (sqlc.narg('to_page')::int IS NULL OR page_number <= sqlc.narg('to_page'));
+
+-- name: GetFileProcessingStatus :one
+SELECT
+    COALESCE((
+        SELECT s.page_count FROM ocr.file_states s
+        WHERE s.file_id = $1 AND s.tenant_id = $2
+    ), 0)::int AS page_count,
+    COUNT(*) FILTER (WHERE text_content IS NOT NULL) AS processed_pages,
+    COUNT(*) FILTER (WHERE error_message IS NOT NULL) AS failed_pages
+FROM ocr.file_pages
+WHERE file_id = $1 AND tenant_id = $2;
+
+-- name: GetPageFiles :many
+SELECT
+    file_id,
+    tenant_id,
+    COUNT(*) AS pages,
+    MAX(created_at)::timestamptz AS last_created_at
+FROM ocr.file_pages
+WHERE file_id > sqlc.arg('after')
+GROUP BY file_id, tenant_id
+ORDER BY file_id
+LIMIT sqlc.arg('limit');
 
 -- name: DeleteFilePagesByFileID :exec
 DELETE FROM ocr.file_pages
@@ -17,10 +67,58 @@ WHERE file_id = $1;
 
 -- name: UpdateFilePageText :exec
 UPDATE ocr.file_pages
-SET text_content = $2
+SET text_content = $2,
+    ocr_model = COALESCE(sqlc.narg('ocr_model'), ocr_model),
+    ocr_provider = COALESCE(sqlc.narg('ocr_provider'), ocr_provider),
+    prompt_version = sqlc.narg('prompt_version')
+WHERE id = $1;
+
+-- name: UpdateFilePageError :exec
+UPDATE ocr.file_pages
+SET error_message = $2
 WHERE id = $1;
 
 -- name: GetFilePageContentByID :one
 SELECT text_content
 FROM ocr.file_pages
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: GetFirstFilePagesText :many
+SELECT page_number, text_content, error_message
+FROM ocr.file_pages
+WHERE file_id = $1 AND page_number < $2
+ORDER BY page_number ASC;
+
+-- name: GetDuplicateFilePage :one
+SELECT id, text_content, ocr_model, ocr_provider, prompt_version
+FROM ocr.file_pages
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND file_id <> sqlc.arg('file_id')
+  AND text_content IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND content_hash = sqlc.arg('content_hash')
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: FileBelongsToTenant :one
+SELECT EXISTS (
+    SELECT 1 FROM ocr.file_pages p
+    WHERE p.file_id = $1 AND p.tenant_id = $2
+      AND NOT EXISTS (
+          SELECT 1 FROM ocr.file_states s
+          WHERE s.file_id = p.file_id AND s.trashed
+      )
+);
+
+-- name: PageBelongsToTenant :one
+SELECT EXISTS (
+    SELECT 1 FROM ocr.file_pages p
+    WHERE p.id = $1 AND p.tenant_id = $2
+      AND NOT EXISTS (
+          SELECT 1 FROM ocr.file_states s
+          WHERE s.file_id = p.file_id AND s.trashed
+      )
+);
@@ -0,0 +1,30 @@
+-- name: SetFilePageCount :exec
+INSERT INTO ocr.file_states (file_id, tenant_id, trashed, changed_at, page_count)
+VALUES (sqlc.arg('file_id'), sqlc.arg('tenant_id'), false, '-infinity', sqlc.arg('page_count'))
+ON CONFLICT (file_id) DO UPDATE
+SET page_count = EXCLUDED.page_count;
+
+-- name: SetFileClassified :execrows
+INSERT INTO ocr.file_states (file_id, tenant_id, trashed, changed_at, classified_at)
+VALUES (sqlc.arg('file_id'), sqlc.arg('tenant_id'), false, '-infinity', NOW())
+ON CONFLICT (file_id) DO UPDATE
+SET classified_at = EXCLUDED.classified_at
+WHERE ocr.file_states.classified_at IS NULL;
+
+-- name: IsFileClassified :one
+SELECT EXISTS (
+    SELECT 1 FROM ocr.file_states
+    WHERE file_id = $1 AND classified_at IS NOT NULL
+);
+
+-- name: SetFilesTrashed :exec
+INSERT INTO ocr.file_states (file_id, tenant_id, trashed, changed_at)
+SELECT unnest(sqlc.arg('file_ids')::uuid[]), sqlc.arg('tenant_id'), sqlc.arg('trashed'), sqlc.arg('changed_at')
+ON CONFLICT (file_id) DO UPDATE
+SET trashed = EXCLUDED.trashed,
+    changed_at = EXCLUDED.changed_at
+WHERE ocr.file_states.changed_at < EXCLUDED.changed_at;
+
+-- name: DeleteFileStateByFileID :exec
+DELETE FROM ocr.file_states
+WHERE file_id = $1;
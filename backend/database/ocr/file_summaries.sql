@@ -0,0 +1,19 @@
+-- name: UpsertFileSummary :exec
+INSERT INTO ocr.file_summaries (file_id, title, summary, page_count, model, prompt_version)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (file_id) DO UPDATE
+SET title = EXCLUDED.title,
+    summary = EXCLUDED.summary,
+    page_count = EXCLUDED.page_count,
+    model = EXCLUDED.model,
+    prompt_version = EXCLUDED.prompt_version,
+    updated_at = NOW();
+
+-- name: GetFileSummary :one
+SELECT *
+FROM ocr.file_summaries
+WHERE file_id = $1;
+
+-- name: DeleteFileSummaryByFileID :exec
+DELETE FROM ocr.file_summaries
+WHERE file_id = $1;
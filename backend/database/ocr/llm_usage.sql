@@ -0,0 +1,20 @@
+-- name: CreateLlmUsage :exec
+INSERT INTO ocr.llm_usage (id, file_id, page_id, agent, model, provider, prompt_tokens, completion_tokens, cost)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetFileLlmUsage :many
+SELECT
+    agent,
+    model,
+    provider,
+    SUM(prompt_tokens)::bigint AS prompt_tokens,
+    SUM(completion_tokens)::bigint AS completion_tokens,
+    SUM(cost)::double precision AS cost
+FROM ocr.llm_usage
+WHERE file_id = $1
+GROUP BY agent, model, provider
+ORDER BY agent, model, provider;
+
+-- name: DeleteLlmUsageByFileID :exec
+DELETE FROM ocr.llm_usage
+WHERE file_id = $1;
@@ -0,0 +1,22 @@
+-- name: CreatePageLayout :exec
+INSERT INTO ocr.page_layouts (page_id, file_id, source, layout)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (page_id) DO UPDATE
+SET source = EXCLUDED.source,
+    layout = EXCLUDED.layout;
+
+-- name: GetPageLayout :one
+SELECT *
+FROM ocr.page_layouts
+WHERE page_id = $1;
+
+-- name: GetFilePageLayouts :many
+SELECT
+    p.id,
+    p.page_number,
+    p.page_image_key,
+    l.layout
+FROM ocr.file_pages p
+JOIN ocr.page_layouts l ON l.page_id = p.id
+WHERE p.file_id = $1
+ORDER BY p.page_number ASC;
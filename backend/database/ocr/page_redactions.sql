@@ -0,0 +1,31 @@
+-- name: UpsertPageRedaction :exec
+INSERT INTO ocr.page_redactions (page_id, file_id, text_content, image_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (page_id) DO UPDATE
+SET text_content = EXCLUDED.text_content,
+    image_key = EXCLUDED.image_key,
+    updated_at = NOW();
+
+-- name: GetPageRedaction :one
+SELECT *
+FROM ocr.page_redactions
+WHERE page_id = $1;
+
+-- name: GetPageRedactionsByFileID :many
+SELECT
+    r.page_id,
+    r.image_key,
+    EXISTS (
+        SELECT 1 FROM ocr.page_entities e
+        WHERE e.page_id = r.page_id
+    ) AS has_entities
+FROM ocr.page_redactions r
+WHERE r.file_id = $1;
+
+-- name: CreatePageEntity :exec
+INSERT INTO ocr.page_entities (id, page_id, file_id, entity_type, detector, start_offset, end_offset, boxes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: DeletePageEntitiesByPageID :exec
+DELETE FROM ocr.page_entities
+WHERE page_id = $1;
@@ -0,0 +1,14 @@
+-- name: UpsertPageTranslation :exec
+INSERT INTO ocr.page_translations (page_id, language, file_id, text_content, model, provider, prompt_version)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (page_id, language) DO UPDATE
+SET text_content = EXCLUDED.text_content,
+    model = EXCLUDED.model,
+    provider = EXCLUDED.provider,
+    prompt_version = EXCLUDED.prompt_version,
+    updated_at = NOW();
+
+-- name: GetPageTranslation :one
+SELECT text_content
+FROM ocr.page_translations
+WHERE page_id = $1 AND language = $2;
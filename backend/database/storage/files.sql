@@ -1,17 +1,166 @@
 -- name: CreateFile :one
-INSERT INTO storage.files (id, file_name, file_size, file_type)
-VALUES ($1, $2, $3, $4)
+INSERT INTO storage.files (id, file_name, file_size, file_type, content_hash, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
--- name: GetFiles :many
+-- name: GetFile :one
+SELECT * FROM storage.files
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL;
+
+-- Listing files is written by hand in internal/storage/files_query.go, its
+-- ORDER BY and keyset depend on the sort field and direction.
+
+-- name: CountFiles :one
+WITH RECURSIVE folders AS (
+    SELECT storage.folders.id FROM storage.folders
+    WHERE storage.folders.id = sqlc.narg('folder_id')
+      AND storage.folders.tenant_id = sqlc.arg('tenant_id')
+    UNION ALL
+    SELECT f.id FROM storage.folders f
+    JOIN folders ON f.parent_id = folders.id
+    WHERE sqlc.arg('include_subfolders')::bool
+)
+SELECT COUNT(*) FROM storage.files files
+WHERE files.tenant_id = sqlc.arg('tenant_id')
+  AND files.deleted_at IS NULL
+  AND (sqlc.narg('document_type')::text IS NULL OR files.document_type = sqlc.narg('document_type'))
+  AND (sqlc.narg('folder_id')::uuid IS NULL OR files.folder_id IN (SELECT folders.id FROM folders))
+  AND files.tags @> sqlc.arg('tags')::text[]
+  AND files.metadata @> sqlc.arg('metadata')::jsonb
+  AND (sqlc.narg('query')::text IS NULL
+    OR files.file_name ILIKE '%' || sqlc.narg('query') || '%'
+    OR files.display_title ILIKE '%' || sqlc.narg('query') || '%')
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR files.created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR files.created_at < sqlc.narg('created_before'))
+  AND (sqlc.narg('min_size')::bigint IS NULL OR files.file_size >= sqlc.narg('min_size'))
+  AND (sqlc.narg('max_size')::bigint IS NULL OR files.file_size <= sqlc.narg('max_size'))
+  AND (cardinality(sqlc.arg('file_types')::text[]) = 0 OR files.file_type = ANY(sqlc.arg('file_types')::text[]));
+
+-- name: GetTrashedFiles :many
 SELECT 
-    *,
+    sqlc.embed(files),
     COUNT(*) OVER() AS total
+FROM storage.files files
+WHERE files.tenant_id = sqlc.arg('tenant_id')
+  AND files.deleted_at IS NOT NULL
+ORDER BY files.deleted_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetTenantUsage :one
+-- Trashed files are left out of the quota, the trash purger removes them
+-- after the retention period.
+SELECT
+    COUNT(*) AS files,
+    COALESCE(SUM(file_size), 0)::bigint AS bytes
 FROM storage.files
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE tenant_id = $1 AND deleted_at IS NULL;
+
+-- name: TrashFilesByIDs :many
+UPDATE storage.files
+SET deleted_at = NOW()
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND tenant_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
+RETURNING id;
 
--- name: DeleteFilesByIDs :exec
+-- name: RestoreFilesByIDs :many
+UPDATE storage.files
+SET deleted_at = NULL
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND tenant_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: PurgeTrashedFiles :many
 DELETE FROM storage.files
-WHERE id = ANY($1::uuid[]);
+WHERE id IN (
+    SELECT id FROM storage.files
+    WHERE deleted_at < sqlc.arg('deleted_before')
+    ORDER BY deleted_at
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, tenant_id;
+
+-- name: GetFileKeys :many
+SELECT id, tenant_id, created_at FROM storage.files
+WHERE id > sqlc.arg('after')
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: DeleteFilesByIDs :many
+DELETE FROM storage.files
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+RETURNING id, tenant_id;
+
+-- name: MoveFilesToFolder :many
+UPDATE storage.files
+SET folder_id = sqlc.narg('folder_id'),
+    updated_at = NOW()
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND tenant_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: MoveFolderFilesToRoot :many
+WITH RECURSIVE folders AS (
+    SELECT storage.folders.id FROM storage.folders
+    WHERE storage.folders.id = sqlc.arg('folder_id')
+      AND storage.folders.tenant_id = sqlc.arg('tenant_id')
+    UNION ALL
+    SELECT f.id FROM storage.folders f
+    JOIN folders ON f.parent_id = folders.id
+)
+UPDATE storage.files
+SET folder_id = NULL,
+    updated_at = NOW()
+WHERE folder_id IN (SELECT folders.id FROM folders)
+RETURNING *;
+
+-- name: UpdateFileTags :many
+UPDATE storage.files
+SET tags = ARRAY(
+        SELECT DISTINCT tag
+        FROM unnest(tags || sqlc.arg('add')::text[]) AS tag
+        WHERE tag <> ALL(sqlc.arg('remove')::text[])
+        ORDER BY tag
+    ),
+    updated_at = NOW()
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND tenant_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateFileMetadata :one
+UPDATE storage.files
+SET metadata = (metadata || sqlc.arg('set')::jsonb) - sqlc.arg('remove')::text[],
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+  AND tenant_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateFile :one
+UPDATE storage.files
+SET file_name = COALESCE(sqlc.narg('file_name'), file_name),
+    display_title = COALESCE(sqlc.narg('display_title'), display_title),
+    document_type = COALESCE(sqlc.narg('document_type'), document_type),
+    language = COALESCE(sqlc.narg('language'), language),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+  AND tenant_id = sqlc.arg('tenant_id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateFileClassification :exec
+UPDATE storage.files
+SET document_type = $2,
+    language = $3,
+    updated_at = NOW()
+WHERE id = $1;
 
+-- name: UpdateFileDisplayTitle :exec
+UPDATE storage.files
+SET display_title = $2,
+    updated_at = NOW()
+WHERE id = $1;
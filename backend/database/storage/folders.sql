@@ -0,0 +1,38 @@
+-- name: CreateFolder :one
+INSERT INTO storage.folders (id, tenant_id, parent_id, name)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetFolder :one
+SELECT * FROM storage.folders
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: GetFolders :many
+SELECT * FROM storage.folders
+WHERE tenant_id = sqlc.arg('tenant_id')
+  AND parent_id IS NOT DISTINCT FROM sqlc.narg('parent_id')
+ORDER BY name ASC;
+
+-- name: UpdateFolder :one
+UPDATE storage.folders
+SET name = $2,
+    parent_id = $3,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $4
+RETURNING *;
+
+-- name: DeleteFolder :execrows
+DELETE FROM storage.folders
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: FolderHasAncestor :one
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id FROM storage.folders
+    WHERE storage.folders.id = sqlc.arg('folder_id')
+    UNION ALL
+    SELECT f.id, f.parent_id FROM storage.folders f
+    JOIN ancestors a ON f.id = a.parent_id
+)
+SELECT EXISTS (
+    SELECT 1 FROM ancestors WHERE ancestors.id = sqlc.arg('ancestor_id')
+);
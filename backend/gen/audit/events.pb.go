@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: audit/events.proto
+
+package audit
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditEventRecordedEventData struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// API key name or token subject, empty for unauthenticated callers
+	Actor         string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	AuthMethod    string `protobuf:"bytes,3,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"`
+	Action        string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType  string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	TraceId       string `protobuf:"bytes,7,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	ClientIp      string `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	OccurredAt    string `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEventRecordedEventData) Reset() {
+	*x = AuditEventRecordedEventData{}
+	mi := &file_audit_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEventRecordedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventRecordedEventData) ProtoMessage() {}
+
+func (x *AuditEventRecordedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventRecordedEventData.ProtoReflect.Descriptor instead.
+func (*AuditEventRecordedEventData) Descriptor() ([]byte, []int) {
+	return file_audit_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEventRecordedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEventRecordedEventData) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+var File_audit_events_proto protoreflect.FileDescriptor
+
+const file_audit_events_proto_rawDesc = "" +
+	"\n" +
+	"\x12audit/events.proto\x12\x05audit\"\xa8\x02\n" +
+	"\x1bAuditEventRecordedEventData\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x1f\n" +
+	"\vauth_method\x18\x03 \x01(\tR\n" +
+	"authMethod\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x05 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x06 \x01(\tR\n" +
+	"resourceId\x12\x19\n" +
+	"\btrace_id\x18\a \x01(\tR\atraceId\x12\x1b\n" +
+	"\tclient_ip\x18\b \x01(\tR\bclientIp\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\tR\n" +
+	"occurredAtB_\n" +
+	"\tcom.auditB\vEventsProtoP\x01Z\x11backend/gen/audit\xa2\x02\x03AXX\xaa\x02\x05Audit\xca\x02\x05Audit\xe2\x02\x11Audit\\GPBMetadata\xea\x02\x05Auditb\x06proto3"
+
+var (
+	file_audit_events_proto_rawDescOnce sync.Once
+	file_audit_events_proto_rawDescData []byte
+)
+
+func file_audit_events_proto_rawDescGZIP() []byte {
+	file_audit_events_proto_rawDescOnce.Do(func() {
+		file_audit_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_events_proto_rawDesc), len(file_audit_events_proto_rawDesc)))
+	})
+	return file_audit_events_proto_rawDescData
+}
+
+var file_audit_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_audit_events_proto_goTypes = []any{
+	(*AuditEventRecordedEventData)(nil), // 0: audit.AuditEventRecordedEventData
+}
+var file_audit_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_audit_events_proto_init() }
+func file_audit_events_proto_init() {
+	if File_audit_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_events_proto_rawDesc), len(file_audit_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_audit_events_proto_goTypes,
+		DependencyIndexes: file_audit_events_proto_depIdxs,
+		MessageInfos:      file_audit_events_proto_msgTypes,
+	}.Build()
+	File_audit_events_proto = out.File
+	file_audit_events_proto_goTypes = nil
+	file_audit_events_proto_depIdxs = nil
+}
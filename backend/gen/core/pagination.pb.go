@@ -22,11 +22,13 @@ const (
 )
 
 type Pagination struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageNumber    int32                  `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	HasNextPage   bool                   `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber  int32                  `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize    int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalItems  int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	HasNextPage bool                   `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	// Opaque cursor of the next page, empty on the last page
+	NextCursor    string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,11 +91,18 @@ func (x *Pagination) GetHasNextPage() bool {
 	return false
 }
 
+func (x *Pagination) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_core_pagination_proto protoreflect.FileDescriptor
 
 const file_core_pagination_proto_rawDesc = "" +
 	"\n" +
-	"\x15core/pagination.proto\x12\x04core\"\x8f\x01\n" +
+	"\x15core/pagination.proto\x12\x04core\"\xb0\x01\n" +
 	"\n" +
 	"Pagination\x12\x1f\n" +
 	"\vpage_number\x18\x01 \x01(\x05R\n" +
@@ -101,7 +110,9 @@ const file_core_pagination_proto_rawDesc = "" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vtotal_items\x18\x03 \x01(\x05R\n" +
 	"totalItems\x12\"\n" +
-	"\rhas_next_page\x18\x04 \x01(\bR\vhasNextPageB]\n" +
+	"\rhas_next_page\x18\x04 \x01(\bR\vhasNextPage\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursorB]\n" +
 	"\bcom.coreB\x0fPaginationProtoP\x01Z\x10backend/gen/core\xa2\x02\x03CXX\xaa\x02\x04Core\xca\x02\x04Core\xe2\x02\x10Core\\GPBMetadata\xea\x02\x04Coreb\x06proto3"
 
 var (
@@ -22,11 +22,21 @@ const (
 )
 
 type FilePageRenderedEventData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
-	PageImageKey  string                 `protobuf:"bytes,2,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
-	PageKey       string                 `protobuf:"bytes,4,opt,name=page_key,json=pageKey,proto3" json:"page_key,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FileKey            string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	PageImageKey       string                 `protobuf:"bytes,2,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
+	PageKey            string                 `protobuf:"bytes,4,opt,name=page_key,json=pageKey,proto3" json:"page_key,omitempty"`
+	PageNumber         int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	ExtractionSchemaId string                 `protobuf:"bytes,5,opt,name=extraction_schema_id,json=extractionSchemaId,proto3" json:"extraction_schema_id,omitempty"`
+	PageCount          int32                  `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	// Word boxes when a layout engine is configured, unset otherwise
+	Layout *PageLayout `protobuf:"bytes,7,opt,name=layout,proto3" json:"layout,omitempty"`
+	// SHA-256 of the rendered PNG, used to reuse OCR results of pages seen
+	// before
+	ContentHash string `protobuf:"bytes,8,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	TenantId    string `protobuf:"bytes,10,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Why the page could not be rendered, the page has no image then
+	RenderError   string `protobuf:"bytes,11,opt,name=render_error,json=renderError,proto3" json:"render_error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,14 +99,59 @@ func (x *FilePageRenderedEventData) GetPageNumber() int32 {
 	return 0
 }
 
+func (x *FilePageRenderedEventData) GetExtractionSchemaId() string {
+	if x != nil {
+		return x.ExtractionSchemaId
+	}
+	return ""
+}
+
+func (x *FilePageRenderedEventData) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FilePageRenderedEventData) GetLayout() *PageLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+func (x *FilePageRenderedEventData) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *FilePageRenderedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FilePageRenderedEventData) GetRenderError() string {
+	if x != nil {
+		return x.RenderError
+	}
+	return ""
+}
+
 type FilePageRegisteredEventData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageImageKey  string                 `protobuf:"bytes,4,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId             string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	PageNumber         int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageImageKey       string                 `protobuf:"bytes,4,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
+	ExtractionSchemaId string                 `protobuf:"bytes,5,opt,name=extraction_schema_id,json=extractionSchemaId,proto3" json:"extraction_schema_id,omitempty"`
+	PageCount          int32                  `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	TenantId           string                 `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *FilePageRegisteredEventData) Reset() {
@@ -157,9 +212,31 @@ func (x *FilePageRegisteredEventData) GetPageImageKey() string {
 	return ""
 }
 
+func (x *FilePageRegisteredEventData) GetExtractionSchemaId() string {
+	if x != nil {
+		return x.ExtractionSchemaId
+	}
+	return ""
+}
+
+func (x *FilePageRegisteredEventData) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FilePageRegisteredEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 type FilePagesDeletedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileKeys      []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -201,14 +278,24 @@ func (x *FilePagesDeletedEventData) GetFileKeys() []string {
 	return nil
 }
 
+func (x *FilePagesDeletedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 type FilePageOcrGeneratedEventData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageImageKey  string                 `protobuf:"bytes,4,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId             string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	PageNumber         int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageImageKey       string                 `protobuf:"bytes,4,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
+	ExtractionSchemaId string                 `protobuf:"bytes,5,opt,name=extraction_schema_id,json=extractionSchemaId,proto3" json:"extraction_schema_id,omitempty"`
+	PageCount          int32                  `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	TenantId           string                 `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *FilePageOcrGeneratedEventData) Reset() {
@@ -269,31 +356,382 @@ func (x *FilePageOcrGeneratedEventData) GetPageImageKey() string {
 	return ""
 }
 
+func (x *FilePageOcrGeneratedEventData) GetExtractionSchemaId() string {
+	if x != nil {
+		return x.ExtractionSchemaId
+	}
+	return ""
+}
+
+func (x *FilePageOcrGeneratedEventData) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FilePageOcrGeneratedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type FileClassifiedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	TenantId      string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileClassifiedEventData) Reset() {
+	*x = FileClassifiedEventData{}
+	mi := &file_ocr_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileClassifiedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileClassifiedEventData) ProtoMessage() {}
+
+func (x *FileClassifiedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileClassifiedEventData.ProtoReflect.Descriptor instead.
+func (*FileClassifiedEventData) Descriptor() ([]byte, []int) {
+	return file_ocr_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FileClassifiedEventData) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+func (x *FileClassifiedEventData) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *FileClassifiedEventData) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *FileClassifiedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type FileSummarizedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileSummarizedEventData) Reset() {
+	*x = FileSummarizedEventData{}
+	mi := &file_ocr_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileSummarizedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSummarizedEventData) ProtoMessage() {}
+
+func (x *FileSummarizedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSummarizedEventData.ProtoReflect.Descriptor instead.
+func (*FileSummarizedEventData) Descriptor() ([]byte, []int) {
+	return file_ocr_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FileSummarizedEventData) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+func (x *FileSummarizedEventData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FileSummarizedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type LlmBudgetExhaustedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Spent         float64                `protobuf:"fixed64,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	Budget        float64                `protobuf:"fixed64,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	ResumeAt      string                 `protobuf:"bytes,4,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LlmBudgetExhaustedEventData) Reset() {
+	*x = LlmBudgetExhaustedEventData{}
+	mi := &file_ocr_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LlmBudgetExhaustedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LlmBudgetExhaustedEventData) ProtoMessage() {}
+
+func (x *LlmBudgetExhaustedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LlmBudgetExhaustedEventData.ProtoReflect.Descriptor instead.
+func (*LlmBudgetExhaustedEventData) Descriptor() ([]byte, []int) {
+	return file_ocr_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LlmBudgetExhaustedEventData) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LlmBudgetExhaustedEventData) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *LlmBudgetExhaustedEventData) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *LlmBudgetExhaustedEventData) GetResumeAt() string {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return ""
+}
+
+type FilePageOcrFailedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	PageNumber    int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageImageKey  string                 `protobuf:"bytes,4,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TenantId      string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageCount     int32                  `protobuf:"varint,7,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilePageOcrFailedEventData) Reset() {
+	*x = FilePageOcrFailedEventData{}
+	mi := &file_ocr_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilePageOcrFailedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilePageOcrFailedEventData) ProtoMessage() {}
+
+func (x *FilePageOcrFailedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilePageOcrFailedEventData.ProtoReflect.Descriptor instead.
+func (*FilePageOcrFailedEventData) Descriptor() ([]byte, []int) {
+	return file_ocr_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FilePageOcrFailedEventData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FilePageOcrFailedEventData) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FilePageOcrFailedEventData) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *FilePageOcrFailedEventData) GetPageImageKey() string {
+	if x != nil {
+		return x.PageImageKey
+	}
+	return ""
+}
+
+func (x *FilePageOcrFailedEventData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FilePageOcrFailedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FilePageOcrFailedEventData) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
 var File_ocr_events_proto protoreflect.FileDescriptor
 
 const file_ocr_events_proto_rawDesc = "" +
 	"\n" +
-	"\x10ocr/events.proto\x12\x03ocr\"\x98\x01\n" +
+	"\x10ocr/events.proto\x12\x03ocr\x1a\x10ocr/layout.proto\"\xfb\x02\n" +
 	"\x19FilePageRenderedEventData\x12\x19\n" +
 	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12$\n" +
 	"\x0epage_image_key\x18\x02 \x01(\tR\fpageImageKey\x12\x19\n" +
 	"\bpage_key\x18\x04 \x01(\tR\apageKey\x12\x1f\n" +
 	"\vpage_number\x18\x03 \x01(\x05R\n" +
-	"pageNumber\"\x8d\x01\n" +
+	"pageNumber\x120\n" +
+	"\x14extraction_schema_id\x18\x05 \x01(\tR\x12extractionSchemaId\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x06 \x01(\x05R\tpageCount\x12'\n" +
+	"\x06layout\x18\a \x01(\v2\x0f.ocr.PageLayoutR\x06layout\x12!\n" +
+	"\fcontent_hash\x18\b \x01(\tR\vcontentHash\x12\x1b\n" +
+	"\ttenant_id\x18\n" +
+	" \x01(\tR\btenantId\x12!\n" +
+	"\frender_error\x18\v \x01(\tR\vrenderErrorJ\x04\b\t\x10\n" +
+	"\"\xfb\x01\n" +
 	"\x1bFilePageRegisteredEventData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1f\n" +
 	"\vpage_number\x18\x03 \x01(\x05R\n" +
 	"pageNumber\x12$\n" +
-	"\x0epage_image_key\x18\x04 \x01(\tR\fpageImageKey\"8\n" +
+	"\x0epage_image_key\x18\x04 \x01(\tR\fpageImageKey\x120\n" +
+	"\x14extraction_schema_id\x18\x05 \x01(\tR\x12extractionSchemaId\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x06 \x01(\x05R\tpageCount\x12\x1b\n" +
+	"\ttenant_id\x18\a \x01(\tR\btenantId\"U\n" +
 	"\x19FilePagesDeletedEventData\x12\x1b\n" +
-	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\"\x8f\x01\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"\xfd\x01\n" +
 	"\x1dFilePageOcrGeneratedEventData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1f\n" +
 	"\vpage_number\x18\x03 \x01(\x05R\n" +
 	"pageNumber\x12$\n" +
-	"\x0epage_image_key\x18\x04 \x01(\tR\fpageImageKeyBS\n" +
+	"\x0epage_image_key\x18\x04 \x01(\tR\fpageImageKey\x120\n" +
+	"\x14extraction_schema_id\x18\x05 \x01(\tR\x12extractionSchemaId\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x06 \x01(\x05R\tpageCount\x12\x1b\n" +
+	"\ttenant_id\x18\a \x01(\tR\btenantId\"\x92\x01\n" +
+	"\x17FileClassifiedEventData\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12#\n" +
+	"\rdocument_type\x18\x02 \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\"g\n" +
+	"\x17FileSummarizedEventData\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\"|\n" +
+	"\x1bLlmBudgetExhaustedEventData\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05spent\x18\x02 \x01(\x01R\x05spent\x12\x16\n" +
+	"\x06budget\x18\x03 \x01(\x01R\x06budget\x12\x1b\n" +
+	"\tresume_at\x18\x04 \x01(\tR\bresumeAt\"\xe0\x01\n" +
+	"\x1aFilePageOcrFailedEventData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x05R\n" +
+	"pageNumber\x12$\n" +
+	"\x0epage_image_key\x18\x04 \x01(\tR\fpageImageKey\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\a \x01(\x05R\tpageCountBS\n" +
 	"\acom.ocrB\vEventsProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
 
 var (
@@ -308,19 +746,25 @@ func file_ocr_events_proto_rawDescGZIP() []byte {
 	return file_ocr_events_proto_rawDescData
 }
 
-var file_ocr_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ocr_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_ocr_events_proto_goTypes = []any{
 	(*FilePageRenderedEventData)(nil),     // 0: ocr.FilePageRenderedEventData
 	(*FilePageRegisteredEventData)(nil),   // 1: ocr.FilePageRegisteredEventData
 	(*FilePagesDeletedEventData)(nil),     // 2: ocr.FilePagesDeletedEventData
 	(*FilePageOcrGeneratedEventData)(nil), // 3: ocr.FilePageOcrGeneratedEventData
+	(*FileClassifiedEventData)(nil),       // 4: ocr.FileClassifiedEventData
+	(*FileSummarizedEventData)(nil),       // 5: ocr.FileSummarizedEventData
+	(*LlmBudgetExhaustedEventData)(nil),   // 6: ocr.LlmBudgetExhaustedEventData
+	(*FilePageOcrFailedEventData)(nil),    // 7: ocr.FilePageOcrFailedEventData
+	(*PageLayout)(nil),                    // 8: ocr.PageLayout
 }
 var file_ocr_events_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8, // 0: ocr.FilePageRenderedEventData.layout:type_name -> ocr.PageLayout
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_ocr_events_proto_init() }
@@ -328,13 +772,14 @@ func file_ocr_events_proto_init() {
 	if File_ocr_events_proto != nil {
 		return
 	}
+	file_ocr_layout_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ocr_events_proto_rawDesc), len(file_ocr_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,757 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: ocr/extraction_schemas.proto
+
+package ocr
+
+import (
+	_ "backend/gen/auth"
+	core "backend/gen/core"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExtractionSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	JsonSchema    string                 `protobuf:"bytes,4,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractionSchema) Reset() {
+	*x = ExtractionSchema{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractionSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractionSchema) ProtoMessage() {}
+
+func (x *ExtractionSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractionSchema.ProtoReflect.Descriptor instead.
+func (*ExtractionSchema) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExtractionSchema) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExtractionSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtractionSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExtractionSchema) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+func (x *ExtractionSchema) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ExtractionSchema) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateExtractionSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	JsonSchema    string                 `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExtractionSchemaRequest) Reset() {
+	*x = CreateExtractionSchemaRequest{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExtractionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExtractionSchemaRequest) ProtoMessage() {}
+
+func (x *CreateExtractionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExtractionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*CreateExtractionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateExtractionSchemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExtractionSchemaRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateExtractionSchemaRequest) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+type GetExtractionSchemasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber    int32                  `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtractionSchemasRequest) Reset() {
+	*x = GetExtractionSchemasRequest{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtractionSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtractionSchemasRequest) ProtoMessage() {}
+
+func (x *GetExtractionSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtractionSchemasRequest.ProtoReflect.Descriptor instead.
+func (*GetExtractionSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetExtractionSchemasRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetExtractionSchemasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetExtractionSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *core.Pagination       `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Schemas       []*ExtractionSchema    `protobuf:"bytes,2,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtractionSchemasResponse) Reset() {
+	*x = GetExtractionSchemasResponse{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtractionSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtractionSchemasResponse) ProtoMessage() {}
+
+func (x *GetExtractionSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtractionSchemasResponse.ProtoReflect.Descriptor instead.
+func (*GetExtractionSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetExtractionSchemasResponse) GetPagination() *core.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetExtractionSchemasResponse) GetSchemas() []*ExtractionSchema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+type GetExtractionSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExtractionSchemaRequest) Reset() {
+	*x = GetExtractionSchemaRequest{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExtractionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExtractionSchemaRequest) ProtoMessage() {}
+
+func (x *GetExtractionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExtractionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetExtractionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetExtractionSchemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateExtractionSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	JsonSchema    string                 `protobuf:"bytes,4,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExtractionSchemaRequest) Reset() {
+	*x = UpdateExtractionSchemaRequest{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExtractionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExtractionSchemaRequest) ProtoMessage() {}
+
+func (x *UpdateExtractionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExtractionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExtractionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateExtractionSchemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateExtractionSchemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateExtractionSchemaRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateExtractionSchemaRequest) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+type DeleteExtractionSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExtractionSchemaRequest) Reset() {
+	*x = DeleteExtractionSchemaRequest{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExtractionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExtractionSchemaRequest) ProtoMessage() {}
+
+func (x *DeleteExtractionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExtractionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExtractionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteExtractionSchemaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFileExtractionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileExtractionsRequest) Reset() {
+	*x = GetFileExtractionsRequest{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileExtractionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileExtractionsRequest) ProtoMessage() {}
+
+func (x *GetFileExtractionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileExtractionsRequest.ProtoReflect.Descriptor instead.
+func (*GetFileExtractionsRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFileExtractionsRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type GetFileExtractionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extractions   []*PageExtraction      `protobuf:"bytes,1,rep,name=extractions,proto3" json:"extractions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileExtractionsResponse) Reset() {
+	*x = GetFileExtractionsResponse{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileExtractionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileExtractionsResponse) ProtoMessage() {}
+
+func (x *GetFileExtractionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileExtractionsResponse.ProtoReflect.Descriptor instead.
+func (*GetFileExtractionsResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFileExtractionsResponse) GetExtractions() []*PageExtraction {
+	if x != nil {
+		return x.Extractions
+	}
+	return nil
+}
+
+type PageExtraction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        string                 `protobuf:"bytes,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageNumber    int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	SchemaId      string                 `protobuf:"bytes,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Data          string                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageExtraction) Reset() {
+	*x = PageExtraction{}
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageExtraction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageExtraction) ProtoMessage() {}
+
+func (x *PageExtraction) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_extraction_schemas_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageExtraction.ProtoReflect.Descriptor instead.
+func (*PageExtraction) Descriptor() ([]byte, []int) {
+	return file_ocr_extraction_schemas_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PageExtraction) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *PageExtraction) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *PageExtraction) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *PageExtraction) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *PageExtraction) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PageExtraction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_ocr_extraction_schemas_proto protoreflect.FileDescriptor
+
+const file_ocr_extraction_schemas_proto_rawDesc = "" +
+	"\n" +
+	"\x1cocr/extraction_schemas.proto\x12\x03ocr\x1a\x0fauth/auth.proto\x1a\x15core/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb7\x01\n" +
+	"\x10ExtractionSchema\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vjson_schema\x18\x04 \x01(\tR\n" +
+	"jsonSchema\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"v\n" +
+	"\x1dCreateExtractionSchemaRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vjson_schema\x18\x03 \x01(\tR\n" +
+	"jsonSchema\"[\n" +
+	"\x1bGetExtractionSchemasRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\x05R\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x81\x01\n" +
+	"\x1cGetExtractionSchemasResponse\x120\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x10.core.PaginationR\n" +
+	"pagination\x12/\n" +
+	"\aschemas\x18\x02 \x03(\v2\x15.ocr.ExtractionSchemaR\aschemas\",\n" +
+	"\x1aGetExtractionSchemaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x86\x01\n" +
+	"\x1dUpdateExtractionSchemaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vjson_schema\x18\x04 \x01(\tR\n" +
+	"jsonSchema\"/\n" +
+	"\x1dDeleteExtractionSchemaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x19GetFileExtractionsRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"S\n" +
+	"\x1aGetFileExtractionsResponse\x125\n" +
+	"\vextractions\x18\x01 \x03(\v2\x13.ocr.PageExtractionR\vextractions\"\xbf\x01\n" +
+	"\x0ePageExtraction\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\tR\x06pageId\x12\x1f\n" +
+	"\vpage_number\x18\x02 \x01(\x05R\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tschema_id\x18\x03 \x01(\tR\bschemaId\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\tR\x04data\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt2\xda\v\n" +
+	"\x17ExtractionSchemaService\x12\x81\x02\n" +
+	"\x16CreateExtractionSchema\x12\".ocr.CreateExtractionSchemaRequest\x1a\x15.ocr.ExtractionSchema\"\xab\x01\x92As\n" +
+	"\n" +
+	"Extraction\x12\x18Create Extraction Schema\x1aKCreates a named JSON schema used to extract structured data from documents.\x82\xb5\x18\x0f\n" +
+	"\rschemas:write\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/ocr/extraction-schemas\x12\xe9\x01\n" +
+	"\x14GetExtractionSchemas\x12 .ocr.GetExtractionSchemasRequest\x1a!.ocr.GetExtractionSchemasResponse\"\x8b\x01\x92AW\n" +
+	"\n" +
+	"Extraction\x12\x16Get Extraction Schemas\x1a1Retrieves a paginated list of extraction schemas.\x82\xb5\x18\x0e\n" +
+	"\fschemas:read\x82\xd3\xe4\x93\x02\x19\x12\x17/ocr/extraction-schemas\x12\xd7\x01\n" +
+	"\x13GetExtractionSchema\x12\x1f.ocr.GetExtractionSchemaRequest\x1a\x15.ocr.ExtractionSchema\"\x87\x01\x92AN\n" +
+	"\n" +
+	"Extraction\x12\x15Get Extraction Schema\x1a)Retrieves an extraction schema by its ID.\x82\xb5\x18\x0e\n" +
+	"\fschemas:read\x82\xd3\xe4\x93\x02\x1e\x12\x1c/ocr/extraction-schemas/{id}\x12\x80\x02\n" +
+	"\x16UpdateExtractionSchema\x12\".ocr.UpdateExtractionSchemaRequest\x1a\x15.ocr.ExtractionSchema\"\xaa\x01\x92Am\n" +
+	"\n" +
+	"Extraction\x12\x18Update Extraction Schema\x1aEUpdates the name, description or JSON schema of an extraction schema.\x82\xb5\x18\x0f\n" +
+	"\rschemas:write\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/ocr/extraction-schemas/{id}\x12\xf1\x01\n" +
+	"\x16DeleteExtractionSchema\x12\".ocr.DeleteExtractionSchemaRequest\x1a\x16.google.protobuf.Empty\"\x9a\x01\x92A`\n" +
+	"\n" +
+	"Extraction\x12\x18Delete Extraction Schema\x1a8Deletes an extraction schema and its extraction results.\x82\xb5\x18\x0f\n" +
+	"\rschemas:write\x82\xd3\xe4\x93\x02\x1e*\x1c/ocr/extraction-schemas/{id}\x12\xfd\x01\n" +
+	"\x12GetFileExtractions\x12\x1e.ocr.GetFileExtractionsRequest\x1a\x1f.ocr.GetFileExtractionsResponse\"\xa5\x01\x92Ae\n" +
+	"\n" +
+	"Extraction\x12\x14Get File Extractions\x1aARetrieves the structured data extracted from each page of a file.\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02'\x12%/storage/files/{file_key}/extractionsB^\n" +
+	"\acom.ocrB\x16ExtractionSchemasProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
+
+var (
+	file_ocr_extraction_schemas_proto_rawDescOnce sync.Once
+	file_ocr_extraction_schemas_proto_rawDescData []byte
+)
+
+func file_ocr_extraction_schemas_proto_rawDescGZIP() []byte {
+	file_ocr_extraction_schemas_proto_rawDescOnce.Do(func() {
+		file_ocr_extraction_schemas_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ocr_extraction_schemas_proto_rawDesc), len(file_ocr_extraction_schemas_proto_rawDesc)))
+	})
+	return file_ocr_extraction_schemas_proto_rawDescData
+}
+
+var file_ocr_extraction_schemas_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ocr_extraction_schemas_proto_goTypes = []any{
+	(*ExtractionSchema)(nil),              // 0: ocr.ExtractionSchema
+	(*CreateExtractionSchemaRequest)(nil), // 1: ocr.CreateExtractionSchemaRequest
+	(*GetExtractionSchemasRequest)(nil),   // 2: ocr.GetExtractionSchemasRequest
+	(*GetExtractionSchemasResponse)(nil),  // 3: ocr.GetExtractionSchemasResponse
+	(*GetExtractionSchemaRequest)(nil),    // 4: ocr.GetExtractionSchemaRequest
+	(*UpdateExtractionSchemaRequest)(nil), // 5: ocr.UpdateExtractionSchemaRequest
+	(*DeleteExtractionSchemaRequest)(nil), // 6: ocr.DeleteExtractionSchemaRequest
+	(*GetFileExtractionsRequest)(nil),     // 7: ocr.GetFileExtractionsRequest
+	(*GetFileExtractionsResponse)(nil),    // 8: ocr.GetFileExtractionsResponse
+	(*PageExtraction)(nil),                // 9: ocr.PageExtraction
+	(*core.Pagination)(nil),               // 10: core.Pagination
+	(*emptypb.Empty)(nil),                 // 11: google.protobuf.Empty
+}
+var file_ocr_extraction_schemas_proto_depIdxs = []int32{
+	10, // 0: ocr.GetExtractionSchemasResponse.pagination:type_name -> core.Pagination
+	0,  // 1: ocr.GetExtractionSchemasResponse.schemas:type_name -> ocr.ExtractionSchema
+	9,  // 2: ocr.GetFileExtractionsResponse.extractions:type_name -> ocr.PageExtraction
+	1,  // 3: ocr.ExtractionSchemaService.CreateExtractionSchema:input_type -> ocr.CreateExtractionSchemaRequest
+	2,  // 4: ocr.ExtractionSchemaService.GetExtractionSchemas:input_type -> ocr.GetExtractionSchemasRequest
+	4,  // 5: ocr.ExtractionSchemaService.GetExtractionSchema:input_type -> ocr.GetExtractionSchemaRequest
+	5,  // 6: ocr.ExtractionSchemaService.UpdateExtractionSchema:input_type -> ocr.UpdateExtractionSchemaRequest
+	6,  // 7: ocr.ExtractionSchemaService.DeleteExtractionSchema:input_type -> ocr.DeleteExtractionSchemaRequest
+	7,  // 8: ocr.ExtractionSchemaService.GetFileExtractions:input_type -> ocr.GetFileExtractionsRequest
+	0,  // 9: ocr.ExtractionSchemaService.CreateExtractionSchema:output_type -> ocr.ExtractionSchema
+	3,  // 10: ocr.ExtractionSchemaService.GetExtractionSchemas:output_type -> ocr.GetExtractionSchemasResponse
+	0,  // 11: ocr.ExtractionSchemaService.GetExtractionSchema:output_type -> ocr.ExtractionSchema
+	0,  // 12: ocr.ExtractionSchemaService.UpdateExtractionSchema:output_type -> ocr.ExtractionSchema
+	11, // 13: ocr.ExtractionSchemaService.DeleteExtractionSchema:output_type -> google.protobuf.Empty
+	8,  // 14: ocr.ExtractionSchemaService.GetFileExtractions:output_type -> ocr.GetFileExtractionsResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ocr_extraction_schemas_proto_init() }
+func file_ocr_extraction_schemas_proto_init() {
+	if File_ocr_extraction_schemas_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ocr_extraction_schemas_proto_rawDesc), len(file_ocr_extraction_schemas_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ocr_extraction_schemas_proto_goTypes,
+		DependencyIndexes: file_ocr_extraction_schemas_proto_depIdxs,
+		MessageInfos:      file_ocr_extraction_schemas_proto_msgTypes,
+	}.Build()
+	File_ocr_extraction_schemas_proto = out.File
+	file_ocr_extraction_schemas_proto_goTypes = nil
+	file_ocr_extraction_schemas_proto_depIdxs = nil
+}
@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: ocr/extraction_schemas.proto
+
+package ocr
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ExtractionSchemaService_CreateExtractionSchema_FullMethodName = "/ocr.ExtractionSchemaService/CreateExtractionSchema"
+	ExtractionSchemaService_GetExtractionSchemas_FullMethodName   = "/ocr.ExtractionSchemaService/GetExtractionSchemas"
+	ExtractionSchemaService_GetExtractionSchema_FullMethodName    = "/ocr.ExtractionSchemaService/GetExtractionSchema"
+	ExtractionSchemaService_UpdateExtractionSchema_FullMethodName = "/ocr.ExtractionSchemaService/UpdateExtractionSchema"
+	ExtractionSchemaService_DeleteExtractionSchema_FullMethodName = "/ocr.ExtractionSchemaService/DeleteExtractionSchema"
+	ExtractionSchemaService_GetFileExtractions_FullMethodName     = "/ocr.ExtractionSchemaService/GetFileExtractions"
+)
+
+// ExtractionSchemaServiceClient is the client API for ExtractionSchemaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExtractionSchemaServiceClient interface {
+	CreateExtractionSchema(ctx context.Context, in *CreateExtractionSchemaRequest, opts ...grpc.CallOption) (*ExtractionSchema, error)
+	GetExtractionSchemas(ctx context.Context, in *GetExtractionSchemasRequest, opts ...grpc.CallOption) (*GetExtractionSchemasResponse, error)
+	GetExtractionSchema(ctx context.Context, in *GetExtractionSchemaRequest, opts ...grpc.CallOption) (*ExtractionSchema, error)
+	UpdateExtractionSchema(ctx context.Context, in *UpdateExtractionSchemaRequest, opts ...grpc.CallOption) (*ExtractionSchema, error)
+	DeleteExtractionSchema(ctx context.Context, in *DeleteExtractionSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFileExtractions(ctx context.Context, in *GetFileExtractionsRequest, opts ...grpc.CallOption) (*GetFileExtractionsResponse, error)
+}
+
+type extractionSchemaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExtractionSchemaServiceClient(cc grpc.ClientConnInterface) ExtractionSchemaServiceClient {
+	return &extractionSchemaServiceClient{cc}
+}
+
+func (c *extractionSchemaServiceClient) CreateExtractionSchema(ctx context.Context, in *CreateExtractionSchemaRequest, opts ...grpc.CallOption) (*ExtractionSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtractionSchema)
+	err := c.cc.Invoke(ctx, ExtractionSchemaService_CreateExtractionSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extractionSchemaServiceClient) GetExtractionSchemas(ctx context.Context, in *GetExtractionSchemasRequest, opts ...grpc.CallOption) (*GetExtractionSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExtractionSchemasResponse)
+	err := c.cc.Invoke(ctx, ExtractionSchemaService_GetExtractionSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extractionSchemaServiceClient) GetExtractionSchema(ctx context.Context, in *GetExtractionSchemaRequest, opts ...grpc.CallOption) (*ExtractionSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtractionSchema)
+	err := c.cc.Invoke(ctx, ExtractionSchemaService_GetExtractionSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extractionSchemaServiceClient) UpdateExtractionSchema(ctx context.Context, in *UpdateExtractionSchemaRequest, opts ...grpc.CallOption) (*ExtractionSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtractionSchema)
+	err := c.cc.Invoke(ctx, ExtractionSchemaService_UpdateExtractionSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extractionSchemaServiceClient) DeleteExtractionSchema(ctx context.Context, in *DeleteExtractionSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExtractionSchemaService_DeleteExtractionSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extractionSchemaServiceClient) GetFileExtractions(ctx context.Context, in *GetFileExtractionsRequest, opts ...grpc.CallOption) (*GetFileExtractionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileExtractionsResponse)
+	err := c.cc.Invoke(ctx, ExtractionSchemaService_GetFileExtractions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExtractionSchemaServiceServer is the server API for ExtractionSchemaService service.
+// All implementations must embed UnimplementedExtractionSchemaServiceServer
+// for forward compatibility.
+type ExtractionSchemaServiceServer interface {
+	CreateExtractionSchema(context.Context, *CreateExtractionSchemaRequest) (*ExtractionSchema, error)
+	GetExtractionSchemas(context.Context, *GetExtractionSchemasRequest) (*GetExtractionSchemasResponse, error)
+	GetExtractionSchema(context.Context, *GetExtractionSchemaRequest) (*ExtractionSchema, error)
+	UpdateExtractionSchema(context.Context, *UpdateExtractionSchemaRequest) (*ExtractionSchema, error)
+	DeleteExtractionSchema(context.Context, *DeleteExtractionSchemaRequest) (*emptypb.Empty, error)
+	GetFileExtractions(context.Context, *GetFileExtractionsRequest) (*GetFileExtractionsResponse, error)
+	mustEmbedUnimplementedExtractionSchemaServiceServer()
+}
+
+// UnimplementedExtractionSchemaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExtractionSchemaServiceServer struct{}
+
+func (UnimplementedExtractionSchemaServiceServer) CreateExtractionSchema(context.Context, *CreateExtractionSchemaRequest) (*ExtractionSchema, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExtractionSchema not implemented")
+}
+func (UnimplementedExtractionSchemaServiceServer) GetExtractionSchemas(context.Context, *GetExtractionSchemasRequest) (*GetExtractionSchemasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExtractionSchemas not implemented")
+}
+func (UnimplementedExtractionSchemaServiceServer) GetExtractionSchema(context.Context, *GetExtractionSchemaRequest) (*ExtractionSchema, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExtractionSchema not implemented")
+}
+func (UnimplementedExtractionSchemaServiceServer) UpdateExtractionSchema(context.Context, *UpdateExtractionSchemaRequest) (*ExtractionSchema, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateExtractionSchema not implemented")
+}
+func (UnimplementedExtractionSchemaServiceServer) DeleteExtractionSchema(context.Context, *DeleteExtractionSchemaRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExtractionSchema not implemented")
+}
+func (UnimplementedExtractionSchemaServiceServer) GetFileExtractions(context.Context, *GetFileExtractionsRequest) (*GetFileExtractionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileExtractions not implemented")
+}
+func (UnimplementedExtractionSchemaServiceServer) mustEmbedUnimplementedExtractionSchemaServiceServer() {
+}
+func (UnimplementedExtractionSchemaServiceServer) testEmbeddedByValue() {}
+
+// UnsafeExtractionSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExtractionSchemaServiceServer will
+// result in compilation errors.
+type UnsafeExtractionSchemaServiceServer interface {
+	mustEmbedUnimplementedExtractionSchemaServiceServer()
+}
+
+func RegisterExtractionSchemaServiceServer(s grpc.ServiceRegistrar, srv ExtractionSchemaServiceServer) {
+	// If the following call panics, it indicates UnimplementedExtractionSchemaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExtractionSchemaService_ServiceDesc, srv)
+}
+
+func _ExtractionSchemaService_CreateExtractionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExtractionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtractionSchemaServiceServer).CreateExtractionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtractionSchemaService_CreateExtractionSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtractionSchemaServiceServer).CreateExtractionSchema(ctx, req.(*CreateExtractionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtractionSchemaService_GetExtractionSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExtractionSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtractionSchemaServiceServer).GetExtractionSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtractionSchemaService_GetExtractionSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtractionSchemaServiceServer).GetExtractionSchemas(ctx, req.(*GetExtractionSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtractionSchemaService_GetExtractionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExtractionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtractionSchemaServiceServer).GetExtractionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtractionSchemaService_GetExtractionSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtractionSchemaServiceServer).GetExtractionSchema(ctx, req.(*GetExtractionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtractionSchemaService_UpdateExtractionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExtractionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtractionSchemaServiceServer).UpdateExtractionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtractionSchemaService_UpdateExtractionSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtractionSchemaServiceServer).UpdateExtractionSchema(ctx, req.(*UpdateExtractionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtractionSchemaService_DeleteExtractionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExtractionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtractionSchemaServiceServer).DeleteExtractionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtractionSchemaService_DeleteExtractionSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtractionSchemaServiceServer).DeleteExtractionSchema(ctx, req.(*DeleteExtractionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtractionSchemaService_GetFileExtractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileExtractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtractionSchemaServiceServer).GetFileExtractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtractionSchemaService_GetFileExtractions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtractionSchemaServiceServer).GetFileExtractions(ctx, req.(*GetFileExtractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExtractionSchemaService_ServiceDesc is the grpc.ServiceDesc for ExtractionSchemaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExtractionSchemaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocr.ExtractionSchemaService",
+	HandlerType: (*ExtractionSchemaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExtractionSchema",
+			Handler:    _ExtractionSchemaService_CreateExtractionSchema_Handler,
+		},
+		{
+			MethodName: "GetExtractionSchemas",
+			Handler:    _ExtractionSchemaService_GetExtractionSchemas_Handler,
+		},
+		{
+			MethodName: "GetExtractionSchema",
+			Handler:    _ExtractionSchemaService_GetExtractionSchema_Handler,
+		},
+		{
+			MethodName: "UpdateExtractionSchema",
+			Handler:    _ExtractionSchemaService_UpdateExtractionSchema_Handler,
+		},
+		{
+			MethodName: "DeleteExtractionSchema",
+			Handler:    _ExtractionSchemaService_DeleteExtractionSchema_Handler,
+		},
+		{
+			MethodName: "GetFileExtractions",
+			Handler:    _ExtractionSchemaService_GetFileExtractions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ocr/extraction_schemas.proto",
+}
@@ -7,6 +7,7 @@
 package ocr
 
 import (
+	_ "backend/gen/auth"
 	core "backend/gen/core"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -25,10 +26,21 @@ const (
 )
 
 type GetFilePagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FileKey    string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	PageNumber int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// First page of the file to return, inclusive
+	FromPage int32 `protobuf:"varint,4,opt,name=from_page,json=fromPage,proto3" json:"from_page,omitempty"`
+	// Last page of the file to return, inclusive
+	ToPage int32 `protobuf:"varint,5,opt,name=to_page,json=toPage,proto3" json:"to_page,omitempty"`
+	// asc or desc, defaults to asc
+	SortOrder string `protobuf:"bytes,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Cursor returned by the previous page, page_number is ignored when set
+	Cursor string `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Skip counting total_items. It is never counted when paginating with a
+	// cursor.
+	SkipTotal     bool `protobuf:"varint,8,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -84,6 +96,41 @@ func (x *GetFilePagesRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *GetFilePagesRequest) GetFromPage() int32 {
+	if x != nil {
+		return x.FromPage
+	}
+	return 0
+}
+
+func (x *GetFilePagesRequest) GetToPage() int32 {
+	if x != nil {
+		return x.ToPage
+	}
+	return 0
+}
+
+func (x *GetFilePagesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetFilePagesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFilePagesRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
 type GetFilePagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *core.Pagination       `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -137,12 +184,21 @@ func (x *GetFilePagesResponse) GetPages() []*FilePage {
 }
 
 type FilePage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageNumber int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	// Redacted page image for callers without unredacted access, empty until
+	// the page is redacted or when its entities could not be located
+	ImageUrl      string `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	OcrModel      string `protobuf:"bytes,4,opt,name=ocr_model,json=ocrModel,proto3" json:"ocr_model,omitempty"`
+	OcrProvider   string `protobuf:"bytes,5,opt,name=ocr_provider,json=ocrProvider,proto3" json:"ocr_provider,omitempty"`
+	PromptVersion string `protobuf:"bytes,6,opt,name=prompt_version,json=promptVersion,proto3" json:"prompt_version,omitempty"`
+	// JPEG previews of the page, empty when the caller only has access to a
+	// redacted page image or to none
+	ThumbnailSmallUrl  string `protobuf:"bytes,7,opt,name=thumbnail_small_url,json=thumbnailSmallUrl,proto3" json:"thumbnail_small_url,omitempty"`
+	ThumbnailMediumUrl string `protobuf:"bytes,8,opt,name=thumbnail_medium_url,json=thumbnailMediumUrl,proto3" json:"thumbnail_medium_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *FilePage) Reset() {
@@ -196,9 +252,47 @@ func (x *FilePage) GetImageUrl() string {
 	return ""
 }
 
+func (x *FilePage) GetOcrModel() string {
+	if x != nil {
+		return x.OcrModel
+	}
+	return ""
+}
+
+func (x *FilePage) GetOcrProvider() string {
+	if x != nil {
+		return x.OcrProvider
+	}
+	return ""
+}
+
+func (x *FilePage) GetPromptVersion() string {
+	if x != nil {
+		return x.PromptVersion
+	}
+	return ""
+}
+
+func (x *FilePage) GetThumbnailSmallUrl() string {
+	if x != nil {
+		return x.ThumbnailSmallUrl
+	}
+	return ""
+}
+
+func (x *FilePage) GetThumbnailMediumUrl() string {
+	if x != nil {
+		return x.ThumbnailMediumUrl
+	}
+	return ""
+}
+
 type GetFilePageContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ISO 639-1 code of a configured translation language, the original text
+	// is returned when empty
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -240,9 +334,20 @@ func (x *GetFilePageContentRequest) GetId() string {
 	return ""
 }
 
+func (x *GetFilePageContentRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type GetFilePageContentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Content  string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Language string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// Set when personal data was replaced because the caller lacks the
+	// files:read_unredacted scope
+	Redacted      bool `protobuf:"varint,3,opt,name=redacted,proto3" json:"redacted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -284,35 +389,536 @@ func (x *GetFilePageContentResponse) GetContent() string {
 	return ""
 }
 
+func (x *GetFilePageContentResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetFilePageContentResponse) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+type GetFileUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileUsageRequest) Reset() {
+	*x = GetFileUsageRequest{}
+	mi := &file_ocr_file_pages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileUsageRequest) ProtoMessage() {}
+
+func (x *GetFileUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetFileUsageRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFileUsageRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type GetFileUsageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PromptTokens     int64                  `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,2,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	Cost             float64                `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Models           []*ModelUsage          `protobuf:"bytes,4,rep,name=models,proto3" json:"models,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetFileUsageResponse) Reset() {
+	*x = GetFileUsageResponse{}
+	mi := &file_ocr_file_pages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileUsageResponse) ProtoMessage() {}
+
+func (x *GetFileUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetFileUsageResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetFileUsageResponse) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *GetFileUsageResponse) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *GetFileUsageResponse) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *GetFileUsageResponse) GetModels() []*ModelUsage {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+type GetFileSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileSummaryRequest) Reset() {
+	*x = GetFileSummaryRequest{}
+	mi := &file_ocr_file_pages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileSummaryRequest) ProtoMessage() {}
+
+func (x *GetFileSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetFileSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFileSummaryRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type GetFileSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	PageCount     int32                  `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Model         string                 `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	PromptVersion string                 `protobuf:"bytes,5,opt,name=prompt_version,json=promptVersion,proto3" json:"prompt_version,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileSummaryResponse) Reset() {
+	*x = GetFileSummaryResponse{}
+	mi := &file_ocr_file_pages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileSummaryResponse) ProtoMessage() {}
+
+func (x *GetFileSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetFileSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFileSummaryResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetFileSummaryResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *GetFileSummaryResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *GetFileSummaryResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetFileSummaryResponse) GetPromptVersion() string {
+	if x != nil {
+		return x.PromptVersion
+	}
+	return ""
+}
+
+func (x *GetFileSummaryResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ExportFileLayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFileLayoutRequest) Reset() {
+	*x = ExportFileLayoutRequest{}
+	mi := &file_ocr_file_pages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFileLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFileLayoutRequest) ProtoMessage() {}
+
+func (x *ExportFileLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFileLayoutRequest.ProtoReflect.Descriptor instead.
+func (*ExportFileLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportFileLayoutRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type ExportFileLayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFileLayoutResponse) Reset() {
+	*x = ExportFileLayoutResponse{}
+	mi := &file_ocr_file_pages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFileLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFileLayoutResponse) ProtoMessage() {}
+
+func (x *ExportFileLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFileLayoutResponse.ProtoReflect.Descriptor instead.
+func (*ExportFileLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportFileLayoutResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExportFileLayoutResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ModelUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Agent            string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Model            string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Provider         string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	PromptTokens     int64                  `protobuf:"varint,4,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,5,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	Cost             float64                `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ModelUsage) Reset() {
+	*x = ModelUsage{}
+	mi := &file_ocr_file_pages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelUsage) ProtoMessage() {}
+
+func (x *ModelUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_file_pages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelUsage.ProtoReflect.Descriptor instead.
+func (*ModelUsage) Descriptor() ([]byte, []int) {
+	return file_ocr_file_pages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModelUsage) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *ModelUsage) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ModelUsage) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ModelUsage) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *ModelUsage) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *ModelUsage) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
 var File_ocr_file_pages_proto protoreflect.FileDescriptor
 
 const file_ocr_file_pages_proto_rawDesc = "" +
 	"\n" +
-	"\x14ocr/file_pages.proto\x12\x03ocr\x1a\x15core/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"n\n" +
+	"\x14ocr/file_pages.proto\x12\x03ocr\x1a\x0fauth/auth.proto\x1a\x15core/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xfa\x01\n" +
 	"\x13GetFilePagesRequest\x12\x19\n" +
 	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12\x1f\n" +
 	"\vpage_number\x18\x02 \x01(\x05R\n" +
 	"pageNumber\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"m\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\tfrom_page\x18\x04 \x01(\x05R\bfromPage\x12\x17\n" +
+	"\ato_page\x18\x05 \x01(\x05R\x06toPage\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\b \x01(\bR\tskipTotal\"m\n" +
 	"\x14GetFilePagesResponse\x120\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x10.core.PaginationR\n" +
 	"pagination\x12#\n" +
-	"\x05pages\x18\x02 \x03(\v2\r.ocr.FilePageR\x05pages\"X\n" +
+	"\x05pages\x18\x02 \x03(\v2\r.ocr.FilePageR\x05pages\"\xa1\x02\n" +
 	"\bFilePage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vpage_number\x18\x02 \x01(\x05R\n" +
 	"pageNumber\x12\x1b\n" +
-	"\timage_url\x18\x03 \x01(\tR\bimageUrl\"+\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x1b\n" +
+	"\tocr_model\x18\x04 \x01(\tR\bocrModel\x12!\n" +
+	"\focr_provider\x18\x05 \x01(\tR\vocrProvider\x12%\n" +
+	"\x0eprompt_version\x18\x06 \x01(\tR\rpromptVersion\x12.\n" +
+	"\x13thumbnail_small_url\x18\a \x01(\tR\x11thumbnailSmallUrl\x120\n" +
+	"\x14thumbnail_medium_url\x18\b \x01(\tR\x12thumbnailMediumUrl\"G\n" +
 	"\x19GetFilePageContentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\"n\n" +
 	"\x1aGetFilePageContentResponse\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent2\x9d\x03\n" +
-	"\x10FilePagesService\x12\xac\x01\n" +
-	"\fGetFilePages\x12\x18.ocr.GetFilePagesRequest\x1a\x19.ocr.GetFilePagesResponse\"g\x92A=\n" +
-	"\x05Files\x12\x0eGet File Pages\x1a$Retrieve pages of a file by file key\x82\xd3\xe4\x93\x02!\x12\x1f/storage/files/{file_key}/pages\x12\xd9\x01\n" +
-	"\x12GetFilePageContent\x12\x1e.ocr.GetFilePageContentRequest\x1a\x1f.ocr.GetFilePageContentResponse\"\x81\x01\x92AV\n" +
-	"\x05Files\x12\x15Get File Page Content\x1a6Retrieve the content of a specific file page by its ID\x82\xd3\xe4\x93\x02\"\x12 /storage/file-pages/{id}/contentBV\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1a\n" +
+	"\bredacted\x18\x03 \x01(\bR\bredacted\"0\n" +
+	"\x13GetFileUsageRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"\xa5\x01\n" +
+	"\x14GetFileUsageResponse\x12#\n" +
+	"\rprompt_tokens\x18\x01 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x02 \x01(\x03R\x10completionTokens\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12'\n" +
+	"\x06models\x18\x04 \x03(\v2\x0f.ocr.ModelUsageR\x06models\"2\n" +
+	"\x15GetFileSummaryRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"\xc3\x01\n" +
+	"\x16GetFileSummaryResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x03 \x01(\x05R\tpageCount\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12%\n" +
+	"\x0eprompt_version\x18\x05 \x01(\tR\rpromptVersion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"4\n" +
+	"\x17ExportFileLayoutRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"W\n" +
+	"\x18ExportFileLayoutResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xba\x01\n" +
+	"\n" +
+	"ModelUsage\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12#\n" +
+	"\rprompt_tokens\x18\x04 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x05 \x01(\x03R\x10completionTokens\x12\x12\n" +
+	"\x04cost\x18\x06 \x01(\x01R\x04cost2\xd8\n" +
+	"\n" +
+	"\x10FilePagesService\x12\xbc\x01\n" +
+	"\fGetFilePages\x12\x18.ocr.GetFilePagesRequest\x1a\x19.ocr.GetFilePagesResponse\"w\x92A=\n" +
+	"\x05Files\x12\x0eGet File Pages\x1a$Retrieve pages of a file by file key\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02!\x12\x1f/storage/files/{file_key}/pages\x12\x90\x02\n" +
+	"\x12GetFilePageContent\x12\x1e.ocr.GetFilePageContentRequest\x1a\x1f.ocr.GetFilePageContentResponse\"\xb8\x01\x92A}\n" +
+	"\x05Files\x12\x15Get File Page Content\x1a]Retrieve the content of a specific file page by its ID, optionally translated into a language\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02\"\x12 /storage/file-pages/{id}/content\x12\xd5\x01\n" +
+	"\fGetFileUsage\x12\x18.ocr.GetFileUsageRequest\x1a\x19.ocr.GetFileUsageResponse\"\x8f\x01\x92AU\n" +
+	"\x05Files\x12\x0eGet File Usage\x1a<Retrieve LLM token usage and cost spent on processing a file\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02!\x12\x1f/storage/files/{file_key}/usage\x12\xd5\x01\n" +
+	"\x0eGetFileSummary\x12\x1a.ocr.GetFileSummaryRequest\x1a\x1b.ocr.GetFileSummaryResponse\"\x89\x01\x92AM\n" +
+	"\x05Files\x12\x10Get File Summary\x1a2Retrieve the generated title and summary of a file\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02#\x12!/storage/files/{file_key}/summary\x12\xdd\x01\n" +
+	"\vGetFileHocr\x12\x1c.ocr.ExportFileLayoutRequest\x1a\x1d.ocr.ExportFileLayoutResponse\"\x90\x01\x92AW\n" +
+	"\x05Files\x12\x10Export File hOCR\x1a<Export the word bounding boxes of a file as an hOCR document\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02 \x12\x1e/storage/files/{file_key}/hocr\x12\xe1\x01\n" +
+	"\vGetFileAlto\x12\x1c.ocr.ExportFileLayoutRequest\x1a\x1d.ocr.ExportFileLayoutResponse\"\x94\x01\x92A[\n" +
+	"\x05Files\x12\x10Export File ALTO\x1a@Export the word bounding boxes of a file as an ALTO XML document\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02 \x12\x1e/storage/files/{file_key}/altoBV\n" +
 	"\acom.ocrB\x0eFilePagesProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
 
 var (
@@ -327,27 +933,43 @@ func file_ocr_file_pages_proto_rawDescGZIP() []byte {
 	return file_ocr_file_pages_proto_rawDescData
 }
 
-var file_ocr_file_pages_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ocr_file_pages_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_ocr_file_pages_proto_goTypes = []any{
 	(*GetFilePagesRequest)(nil),        // 0: ocr.GetFilePagesRequest
 	(*GetFilePagesResponse)(nil),       // 1: ocr.GetFilePagesResponse
 	(*FilePage)(nil),                   // 2: ocr.FilePage
 	(*GetFilePageContentRequest)(nil),  // 3: ocr.GetFilePageContentRequest
 	(*GetFilePageContentResponse)(nil), // 4: ocr.GetFilePageContentResponse
-	(*core.Pagination)(nil),            // 5: core.Pagination
+	(*GetFileUsageRequest)(nil),        // 5: ocr.GetFileUsageRequest
+	(*GetFileUsageResponse)(nil),       // 6: ocr.GetFileUsageResponse
+	(*GetFileSummaryRequest)(nil),      // 7: ocr.GetFileSummaryRequest
+	(*GetFileSummaryResponse)(nil),     // 8: ocr.GetFileSummaryResponse
+	(*ExportFileLayoutRequest)(nil),    // 9: ocr.ExportFileLayoutRequest
+	(*ExportFileLayoutResponse)(nil),   // 10: ocr.ExportFileLayoutResponse
+	(*ModelUsage)(nil),                 // 11: ocr.ModelUsage
+	(*core.Pagination)(nil),            // 12: core.Pagination
 }
 var file_ocr_file_pages_proto_depIdxs = []int32{
-	5, // 0: ocr.GetFilePagesResponse.pagination:type_name -> core.Pagination
-	2, // 1: ocr.GetFilePagesResponse.pages:type_name -> ocr.FilePage
-	0, // 2: ocr.FilePagesService.GetFilePages:input_type -> ocr.GetFilePagesRequest
-	3, // 3: ocr.FilePagesService.GetFilePageContent:input_type -> ocr.GetFilePageContentRequest
-	1, // 4: ocr.FilePagesService.GetFilePages:output_type -> ocr.GetFilePagesResponse
-	4, // 5: ocr.FilePagesService.GetFilePageContent:output_type -> ocr.GetFilePageContentResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: ocr.GetFilePagesResponse.pagination:type_name -> core.Pagination
+	2,  // 1: ocr.GetFilePagesResponse.pages:type_name -> ocr.FilePage
+	11, // 2: ocr.GetFileUsageResponse.models:type_name -> ocr.ModelUsage
+	0,  // 3: ocr.FilePagesService.GetFilePages:input_type -> ocr.GetFilePagesRequest
+	3,  // 4: ocr.FilePagesService.GetFilePageContent:input_type -> ocr.GetFilePageContentRequest
+	5,  // 5: ocr.FilePagesService.GetFileUsage:input_type -> ocr.GetFileUsageRequest
+	7,  // 6: ocr.FilePagesService.GetFileSummary:input_type -> ocr.GetFileSummaryRequest
+	9,  // 7: ocr.FilePagesService.GetFileHocr:input_type -> ocr.ExportFileLayoutRequest
+	9,  // 8: ocr.FilePagesService.GetFileAlto:input_type -> ocr.ExportFileLayoutRequest
+	1,  // 9: ocr.FilePagesService.GetFilePages:output_type -> ocr.GetFilePagesResponse
+	4,  // 10: ocr.FilePagesService.GetFilePageContent:output_type -> ocr.GetFilePageContentResponse
+	6,  // 11: ocr.FilePagesService.GetFileUsage:output_type -> ocr.GetFileUsageResponse
+	8,  // 12: ocr.FilePagesService.GetFileSummary:output_type -> ocr.GetFileSummaryResponse
+	10, // 13: ocr.FilePagesService.GetFileHocr:output_type -> ocr.ExportFileLayoutResponse
+	10, // 14: ocr.FilePagesService.GetFileAlto:output_type -> ocr.ExportFileLayoutResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_ocr_file_pages_proto_init() }
@@ -361,7 +983,7 @@ func file_ocr_file_pages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ocr_file_pages_proto_rawDesc), len(file_ocr_file_pages_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
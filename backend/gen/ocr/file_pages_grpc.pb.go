@@ -21,6 +21,10 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	FilePagesService_GetFilePages_FullMethodName       = "/ocr.FilePagesService/GetFilePages"
 	FilePagesService_GetFilePageContent_FullMethodName = "/ocr.FilePagesService/GetFilePageContent"
+	FilePagesService_GetFileUsage_FullMethodName       = "/ocr.FilePagesService/GetFileUsage"
+	FilePagesService_GetFileSummary_FullMethodName     = "/ocr.FilePagesService/GetFileSummary"
+	FilePagesService_GetFileHocr_FullMethodName        = "/ocr.FilePagesService/GetFileHocr"
+	FilePagesService_GetFileAlto_FullMethodName        = "/ocr.FilePagesService/GetFileAlto"
 )
 
 // FilePagesServiceClient is the client API for FilePagesService service.
@@ -29,6 +33,10 @@ const (
 type FilePagesServiceClient interface {
 	GetFilePages(ctx context.Context, in *GetFilePagesRequest, opts ...grpc.CallOption) (*GetFilePagesResponse, error)
 	GetFilePageContent(ctx context.Context, in *GetFilePageContentRequest, opts ...grpc.CallOption) (*GetFilePageContentResponse, error)
+	GetFileUsage(ctx context.Context, in *GetFileUsageRequest, opts ...grpc.CallOption) (*GetFileUsageResponse, error)
+	GetFileSummary(ctx context.Context, in *GetFileSummaryRequest, opts ...grpc.CallOption) (*GetFileSummaryResponse, error)
+	GetFileHocr(ctx context.Context, in *ExportFileLayoutRequest, opts ...grpc.CallOption) (*ExportFileLayoutResponse, error)
+	GetFileAlto(ctx context.Context, in *ExportFileLayoutRequest, opts ...grpc.CallOption) (*ExportFileLayoutResponse, error)
 }
 
 type filePagesServiceClient struct {
@@ -59,12 +67,56 @@ func (c *filePagesServiceClient) GetFilePageContent(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *filePagesServiceClient) GetFileUsage(ctx context.Context, in *GetFileUsageRequest, opts ...grpc.CallOption) (*GetFileUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileUsageResponse)
+	err := c.cc.Invoke(ctx, FilePagesService_GetFileUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filePagesServiceClient) GetFileSummary(ctx context.Context, in *GetFileSummaryRequest, opts ...grpc.CallOption) (*GetFileSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileSummaryResponse)
+	err := c.cc.Invoke(ctx, FilePagesService_GetFileSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filePagesServiceClient) GetFileHocr(ctx context.Context, in *ExportFileLayoutRequest, opts ...grpc.CallOption) (*ExportFileLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportFileLayoutResponse)
+	err := c.cc.Invoke(ctx, FilePagesService_GetFileHocr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filePagesServiceClient) GetFileAlto(ctx context.Context, in *ExportFileLayoutRequest, opts ...grpc.CallOption) (*ExportFileLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportFileLayoutResponse)
+	err := c.cc.Invoke(ctx, FilePagesService_GetFileAlto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FilePagesServiceServer is the server API for FilePagesService service.
 // All implementations must embed UnimplementedFilePagesServiceServer
 // for forward compatibility.
 type FilePagesServiceServer interface {
 	GetFilePages(context.Context, *GetFilePagesRequest) (*GetFilePagesResponse, error)
 	GetFilePageContent(context.Context, *GetFilePageContentRequest) (*GetFilePageContentResponse, error)
+	GetFileUsage(context.Context, *GetFileUsageRequest) (*GetFileUsageResponse, error)
+	GetFileSummary(context.Context, *GetFileSummaryRequest) (*GetFileSummaryResponse, error)
+	GetFileHocr(context.Context, *ExportFileLayoutRequest) (*ExportFileLayoutResponse, error)
+	GetFileAlto(context.Context, *ExportFileLayoutRequest) (*ExportFileLayoutResponse, error)
 	mustEmbedUnimplementedFilePagesServiceServer()
 }
 
@@ -81,6 +133,18 @@ func (UnimplementedFilePagesServiceServer) GetFilePages(context.Context, *GetFil
 func (UnimplementedFilePagesServiceServer) GetFilePageContent(context.Context, *GetFilePageContentRequest) (*GetFilePageContentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFilePageContent not implemented")
 }
+func (UnimplementedFilePagesServiceServer) GetFileUsage(context.Context, *GetFileUsageRequest) (*GetFileUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileUsage not implemented")
+}
+func (UnimplementedFilePagesServiceServer) GetFileSummary(context.Context, *GetFileSummaryRequest) (*GetFileSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileSummary not implemented")
+}
+func (UnimplementedFilePagesServiceServer) GetFileHocr(context.Context, *ExportFileLayoutRequest) (*ExportFileLayoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileHocr not implemented")
+}
+func (UnimplementedFilePagesServiceServer) GetFileAlto(context.Context, *ExportFileLayoutRequest) (*ExportFileLayoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileAlto not implemented")
+}
 func (UnimplementedFilePagesServiceServer) mustEmbedUnimplementedFilePagesServiceServer() {}
 func (UnimplementedFilePagesServiceServer) testEmbeddedByValue()                          {}
 
@@ -138,6 +202,78 @@ func _FilePagesService_GetFilePageContent_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FilePagesService_GetFileUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilePagesServiceServer).GetFileUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilePagesService_GetFileUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilePagesServiceServer).GetFileUsage(ctx, req.(*GetFileUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilePagesService_GetFileSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilePagesServiceServer).GetFileSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilePagesService_GetFileSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilePagesServiceServer).GetFileSummary(ctx, req.(*GetFileSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilePagesService_GetFileHocr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportFileLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilePagesServiceServer).GetFileHocr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilePagesService_GetFileHocr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilePagesServiceServer).GetFileHocr(ctx, req.(*ExportFileLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilePagesService_GetFileAlto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportFileLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilePagesServiceServer).GetFileAlto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilePagesService_GetFileAlto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilePagesServiceServer).GetFileAlto(ctx, req.(*ExportFileLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FilePagesService_ServiceDesc is the grpc.ServiceDesc for FilePagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +289,22 @@ var FilePagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFilePageContent",
 			Handler:    _FilePagesService_GetFilePageContent_Handler,
 		},
+		{
+			MethodName: "GetFileUsage",
+			Handler:    _FilePagesService_GetFileUsage_Handler,
+		},
+		{
+			MethodName: "GetFileSummary",
+			Handler:    _FilePagesService_GetFileSummary_Handler,
+		},
+		{
+			MethodName: "GetFileHocr",
+			Handler:    _FilePagesService_GetFileHocr_Handler,
+		},
+		{
+			MethodName: "GetFileAlto",
+			Handler:    _FilePagesService_GetFileAlto_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ocr/file_pages.proto",
@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: ocr/layout.proto
+
+package ocr
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PageLayout holds the lines and words of a page with boxes in pixels of the
+// rendered page image.
+type PageLayout struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Width  int32                  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Engine that produced the boxes: "fitz" for the PDF text layer or
+	// "tesseract"
+	Source        string        `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Lines         []*LayoutLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageLayout) Reset() {
+	*x = PageLayout{}
+	mi := &file_ocr_layout_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageLayout) ProtoMessage() {}
+
+func (x *PageLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_layout_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageLayout.ProtoReflect.Descriptor instead.
+func (*PageLayout) Descriptor() ([]byte, []int) {
+	return file_ocr_layout_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PageLayout) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PageLayout) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PageLayout) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PageLayout) GetLines() []*LayoutLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type LayoutLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Box           *LayoutBox             `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Words         []*LayoutWord          `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LayoutLine) Reset() {
+	*x = LayoutLine{}
+	mi := &file_ocr_layout_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LayoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutLine) ProtoMessage() {}
+
+func (x *LayoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_layout_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutLine.ProtoReflect.Descriptor instead.
+func (*LayoutLine) Descriptor() ([]byte, []int) {
+	return file_ocr_layout_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LayoutLine) GetBox() *LayoutBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *LayoutLine) GetWords() []*LayoutWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type LayoutWord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Box   *LayoutBox             `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Text  string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Recognition confidence between 0 and 1, 1 for text layer words
+	Confidence    float32 `protobuf:"fixed32,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LayoutWord) Reset() {
+	*x = LayoutWord{}
+	mi := &file_ocr_layout_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LayoutWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutWord) ProtoMessage() {}
+
+func (x *LayoutWord) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_layout_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutWord.ProtoReflect.Descriptor instead.
+func (*LayoutWord) Descriptor() ([]byte, []int) {
+	return file_ocr_layout_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LayoutWord) GetBox() *LayoutBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *LayoutWord) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LayoutWord) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type LayoutBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LayoutBox) Reset() {
+	*x = LayoutBox{}
+	mi := &file_ocr_layout_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LayoutBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutBox) ProtoMessage() {}
+
+func (x *LayoutBox) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_layout_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutBox.ProtoReflect.Descriptor instead.
+func (*LayoutBox) Descriptor() ([]byte, []int) {
+	return file_ocr_layout_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LayoutBox) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *LayoutBox) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *LayoutBox) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *LayoutBox) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+var File_ocr_layout_proto protoreflect.FileDescriptor
+
+const file_ocr_layout_proto_rawDesc = "" +
+	"\n" +
+	"\x10ocr/layout.proto\x12\x03ocr\"y\n" +
+	"\n" +
+	"PageLayout\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12%\n" +
+	"\x05lines\x18\x04 \x03(\v2\x0f.ocr.LayoutLineR\x05lines\"U\n" +
+	"\n" +
+	"LayoutLine\x12 \n" +
+	"\x03box\x18\x01 \x01(\v2\x0e.ocr.LayoutBoxR\x03box\x12%\n" +
+	"\x05words\x18\x02 \x03(\v2\x0f.ocr.LayoutWordR\x05words\"b\n" +
+	"\n" +
+	"LayoutWord\x12 \n" +
+	"\x03box\x18\x01 \x01(\v2\x0e.ocr.LayoutBoxR\x03box\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x02R\n" +
+	"confidence\"U\n" +
+	"\tLayoutBox\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06heightBS\n" +
+	"\acom.ocrB\vLayoutProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
+
+var (
+	file_ocr_layout_proto_rawDescOnce sync.Once
+	file_ocr_layout_proto_rawDescData []byte
+)
+
+func file_ocr_layout_proto_rawDescGZIP() []byte {
+	file_ocr_layout_proto_rawDescOnce.Do(func() {
+		file_ocr_layout_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ocr_layout_proto_rawDesc), len(file_ocr_layout_proto_rawDesc)))
+	})
+	return file_ocr_layout_proto_rawDescData
+}
+
+var file_ocr_layout_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ocr_layout_proto_goTypes = []any{
+	(*PageLayout)(nil), // 0: ocr.PageLayout
+	(*LayoutLine)(nil), // 1: ocr.LayoutLine
+	(*LayoutWord)(nil), // 2: ocr.LayoutWord
+	(*LayoutBox)(nil),  // 3: ocr.LayoutBox
+}
+var file_ocr_layout_proto_depIdxs = []int32{
+	1, // 0: ocr.PageLayout.lines:type_name -> ocr.LayoutLine
+	3, // 1: ocr.LayoutLine.box:type_name -> ocr.LayoutBox
+	2, // 2: ocr.LayoutLine.words:type_name -> ocr.LayoutWord
+	3, // 3: ocr.LayoutWord.box:type_name -> ocr.LayoutBox
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ocr_layout_proto_init() }
+func file_ocr_layout_proto_init() {
+	if File_ocr_layout_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ocr_layout_proto_rawDesc), len(file_ocr_layout_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ocr_layout_proto_goTypes,
+		DependencyIndexes: file_ocr_layout_proto_depIdxs,
+		MessageInfos:      file_ocr_layout_proto_msgTypes,
+	}.Build()
+	File_ocr_layout_proto = out.File
+	file_ocr_layout_proto_goTypes = nil
+	file_ocr_layout_proto_depIdxs = nil
+}
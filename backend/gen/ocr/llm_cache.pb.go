@@ -0,0 +1,568 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: ocr/llm_cache.proto
+
+package ocr
+
+import (
+	_ "backend/gen/auth"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetCacheStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCacheStatsRequest) Reset() {
+	*x = GetCacheStatsRequest{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsRequest) ProtoMessage() {}
+
+func (x *GetCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{0}
+}
+
+type GetCacheStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       uint64                 `protobuf:"varint,1,opt,name=entries,proto3" json:"entries,omitempty"`
+	Bytes         uint64                 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Ttl           string                 `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCacheStatsResponse) Reset() {
+	*x = GetCacheStatsResponse{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCacheStatsResponse) GetEntries() uint64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type InspectFileCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectFileCacheRequest) Reset() {
+	*x = InspectFileCacheRequest{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectFileCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectFileCacheRequest) ProtoMessage() {}
+
+func (x *InspectFileCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectFileCacheRequest.ProtoReflect.Descriptor instead.
+func (*InspectFileCacheRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InspectFileCacheRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type InspectFileCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         []*CachedPage          `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectFileCacheResponse) Reset() {
+	*x = InspectFileCacheResponse{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectFileCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectFileCacheResponse) ProtoMessage() {}
+
+func (x *InspectFileCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectFileCacheResponse.ProtoReflect.Descriptor instead.
+func (*InspectFileCacheResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InspectFileCacheResponse) GetPages() []*CachedPage {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+type CachedPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageImageKey  string                 `protobuf:"bytes,1,opt,name=page_image_key,json=pageImageKey,proto3" json:"page_image_key,omitempty"`
+	ImageSha256   string                 `protobuf:"bytes,2,opt,name=image_sha256,json=imageSha256,proto3" json:"image_sha256,omitempty"`
+	Entries       []*CacheEntry          `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CachedPage) Reset() {
+	*x = CachedPage{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CachedPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachedPage) ProtoMessage() {}
+
+func (x *CachedPage) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachedPage.ProtoReflect.Descriptor instead.
+func (*CachedPage) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CachedPage) GetPageImageKey() string {
+	if x != nil {
+		return x.PageImageKey
+	}
+	return ""
+}
+
+func (x *CachedPage) GetImageSha256() string {
+	if x != nil {
+		return x.ImageSha256
+	}
+	return ""
+}
+
+func (x *CachedPage) GetEntries() []*CacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type CacheEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheEntry) Reset() {
+	*x = CacheEntry{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntry) ProtoMessage() {}
+
+func (x *CacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntry.ProtoReflect.Descriptor instead.
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CacheEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type InvalidateFileCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateFileCacheRequest) Reset() {
+	*x = InvalidateFileCacheRequest{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateFileCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateFileCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateFileCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateFileCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateFileCacheRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InvalidateFileCacheRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type InvalidatePromptVersionCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptVersion string                 `protobuf:"bytes,1,opt,name=prompt_version,json=promptVersion,proto3" json:"prompt_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidatePromptVersionCacheRequest) Reset() {
+	*x = InvalidatePromptVersionCacheRequest{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidatePromptVersionCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidatePromptVersionCacheRequest) ProtoMessage() {}
+
+func (x *InvalidatePromptVersionCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidatePromptVersionCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidatePromptVersionCacheRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InvalidatePromptVersionCacheRequest) GetPromptVersion() string {
+	if x != nil {
+		return x.PromptVersion
+	}
+	return ""
+}
+
+type InvalidateCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int32                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheResponse) Reset() {
+	*x = InvalidateCacheResponse{}
+	mi := &file_ocr_llm_cache_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_llm_cache_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_llm_cache_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InvalidateCacheResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+var File_ocr_llm_cache_proto protoreflect.FileDescriptor
+
+const file_ocr_llm_cache_proto_rawDesc = "" +
+	"\n" +
+	"\x13ocr/llm_cache.proto\x12\x03ocr\x1a\x0fauth/auth.proto\x1a\x1cgoogle/api/annotations.proto\"\x16\n" +
+	"\x14GetCacheStatsRequest\"Y\n" +
+	"\x15GetCacheStatsResponse\x12\x18\n" +
+	"\aentries\x18\x01 \x01(\x04R\aentries\x12\x14\n" +
+	"\x05bytes\x18\x02 \x01(\x04R\x05bytes\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\tR\x03ttl\"4\n" +
+	"\x17InspectFileCacheRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"A\n" +
+	"\x18InspectFileCacheResponse\x12%\n" +
+	"\x05pages\x18\x01 \x03(\v2\x0f.ocr.CachedPageR\x05pages\"\x80\x01\n" +
+	"\n" +
+	"CachedPage\x12$\n" +
+	"\x0epage_image_key\x18\x01 \x01(\tR\fpageImageKey\x12!\n" +
+	"\fimage_sha256\x18\x02 \x01(\tR\vimageSha256\x12)\n" +
+	"\aentries\x18\x03 \x03(\v2\x0f.ocr.CacheEntryR\aentries\"Q\n" +
+	"\n" +
+	"CacheEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"7\n" +
+	"\x1aInvalidateFileCacheRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"L\n" +
+	"#InvalidatePromptVersionCacheRequest\x12%\n" +
+	"\x0eprompt_version\x18\x01 \x01(\tR\rpromptVersion\"3\n" +
+	"\x17InvalidateCacheResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x05R\adeleted2\xda\x04\n" +
+	"\x0fLlmCacheService\x12s\n" +
+	"\rGetCacheStats\x12\x19.ocr.GetCacheStatsRequest\x1a\x1a.ocr.GetCacheStatsResponse\"+\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02\x16\x12\x14/_internal/llm/cache\x12\x8d\x01\n" +
+	"\x10InspectFileCache\x12\x1c.ocr.InspectFileCacheRequest\x1a\x1d.ocr.InspectFileCacheResponse\"<\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02'\x12%/_internal/llm/cache/files/{file_key}\x12\x92\x01\n" +
+	"\x13InvalidateFileCache\x12\x1f.ocr.InvalidateFileCacheRequest\x1a\x1c.ocr.InvalidateCacheResponse\"<\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02'*%/_internal/llm/cache/files/{file_key}\x12\xac\x01\n" +
+	"\x1cInvalidatePromptVersionCache\x12(.ocr.InvalidatePromptVersionCacheRequest\x1a\x1c.ocr.InvalidateCacheResponse\"D\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02/*-/_internal/llm/cache/prompts/{prompt_version}BU\n" +
+	"\acom.ocrB\rLlmCacheProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
+
+var (
+	file_ocr_llm_cache_proto_rawDescOnce sync.Once
+	file_ocr_llm_cache_proto_rawDescData []byte
+)
+
+func file_ocr_llm_cache_proto_rawDescGZIP() []byte {
+	file_ocr_llm_cache_proto_rawDescOnce.Do(func() {
+		file_ocr_llm_cache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ocr_llm_cache_proto_rawDesc), len(file_ocr_llm_cache_proto_rawDesc)))
+	})
+	return file_ocr_llm_cache_proto_rawDescData
+}
+
+var file_ocr_llm_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ocr_llm_cache_proto_goTypes = []any{
+	(*GetCacheStatsRequest)(nil),                // 0: ocr.GetCacheStatsRequest
+	(*GetCacheStatsResponse)(nil),               // 1: ocr.GetCacheStatsResponse
+	(*InspectFileCacheRequest)(nil),             // 2: ocr.InspectFileCacheRequest
+	(*InspectFileCacheResponse)(nil),            // 3: ocr.InspectFileCacheResponse
+	(*CachedPage)(nil),                          // 4: ocr.CachedPage
+	(*CacheEntry)(nil),                          // 5: ocr.CacheEntry
+	(*InvalidateFileCacheRequest)(nil),          // 6: ocr.InvalidateFileCacheRequest
+	(*InvalidatePromptVersionCacheRequest)(nil), // 7: ocr.InvalidatePromptVersionCacheRequest
+	(*InvalidateCacheResponse)(nil),             // 8: ocr.InvalidateCacheResponse
+}
+var file_ocr_llm_cache_proto_depIdxs = []int32{
+	4, // 0: ocr.InspectFileCacheResponse.pages:type_name -> ocr.CachedPage
+	5, // 1: ocr.CachedPage.entries:type_name -> ocr.CacheEntry
+	0, // 2: ocr.LlmCacheService.GetCacheStats:input_type -> ocr.GetCacheStatsRequest
+	2, // 3: ocr.LlmCacheService.InspectFileCache:input_type -> ocr.InspectFileCacheRequest
+	6, // 4: ocr.LlmCacheService.InvalidateFileCache:input_type -> ocr.InvalidateFileCacheRequest
+	7, // 5: ocr.LlmCacheService.InvalidatePromptVersionCache:input_type -> ocr.InvalidatePromptVersionCacheRequest
+	1, // 6: ocr.LlmCacheService.GetCacheStats:output_type -> ocr.GetCacheStatsResponse
+	3, // 7: ocr.LlmCacheService.InspectFileCache:output_type -> ocr.InspectFileCacheResponse
+	8, // 8: ocr.LlmCacheService.InvalidateFileCache:output_type -> ocr.InvalidateCacheResponse
+	8, // 9: ocr.LlmCacheService.InvalidatePromptVersionCache:output_type -> ocr.InvalidateCacheResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ocr_llm_cache_proto_init() }
+func file_ocr_llm_cache_proto_init() {
+	if File_ocr_llm_cache_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ocr_llm_cache_proto_rawDesc), len(file_ocr_llm_cache_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ocr_llm_cache_proto_goTypes,
+		DependencyIndexes: file_ocr_llm_cache_proto_depIdxs,
+		MessageInfos:      file_ocr_llm_cache_proto_msgTypes,
+	}.Build()
+	File_ocr_llm_cache_proto = out.File
+	file_ocr_llm_cache_proto_goTypes = nil
+	file_ocr_llm_cache_proto_depIdxs = nil
+}
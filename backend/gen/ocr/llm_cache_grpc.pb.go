@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: ocr/llm_cache.proto
+
+package ocr
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LlmCacheService_GetCacheStats_FullMethodName                = "/ocr.LlmCacheService/GetCacheStats"
+	LlmCacheService_InspectFileCache_FullMethodName             = "/ocr.LlmCacheService/InspectFileCache"
+	LlmCacheService_InvalidateFileCache_FullMethodName          = "/ocr.LlmCacheService/InvalidateFileCache"
+	LlmCacheService_InvalidatePromptVersionCache_FullMethodName = "/ocr.LlmCacheService/InvalidatePromptVersionCache"
+)
+
+// LlmCacheServiceClient is the client API for LlmCacheService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LlmCacheServiceClient interface {
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
+	InspectFileCache(ctx context.Context, in *InspectFileCacheRequest, opts ...grpc.CallOption) (*InspectFileCacheResponse, error)
+	InvalidateFileCache(ctx context.Context, in *InvalidateFileCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error)
+	InvalidatePromptVersionCache(ctx context.Context, in *InvalidatePromptVersionCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error)
+}
+
+type llmCacheServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLlmCacheServiceClient(cc grpc.ClientConnInterface) LlmCacheServiceClient {
+	return &llmCacheServiceClient{cc}
+}
+
+func (c *llmCacheServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, LlmCacheService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCacheServiceClient) InspectFileCache(ctx context.Context, in *InspectFileCacheRequest, opts ...grpc.CallOption) (*InspectFileCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InspectFileCacheResponse)
+	err := c.cc.Invoke(ctx, LlmCacheService_InspectFileCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCacheServiceClient) InvalidateFileCache(ctx context.Context, in *InvalidateFileCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateCacheResponse)
+	err := c.cc.Invoke(ctx, LlmCacheService_InvalidateFileCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *llmCacheServiceClient) InvalidatePromptVersionCache(ctx context.Context, in *InvalidatePromptVersionCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateCacheResponse)
+	err := c.cc.Invoke(ctx, LlmCacheService_InvalidatePromptVersionCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LlmCacheServiceServer is the server API for LlmCacheService service.
+// All implementations must embed UnimplementedLlmCacheServiceServer
+// for forward compatibility.
+type LlmCacheServiceServer interface {
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
+	InspectFileCache(context.Context, *InspectFileCacheRequest) (*InspectFileCacheResponse, error)
+	InvalidateFileCache(context.Context, *InvalidateFileCacheRequest) (*InvalidateCacheResponse, error)
+	InvalidatePromptVersionCache(context.Context, *InvalidatePromptVersionCacheRequest) (*InvalidateCacheResponse, error)
+	mustEmbedUnimplementedLlmCacheServiceServer()
+}
+
+// UnimplementedLlmCacheServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLlmCacheServiceServer struct{}
+
+func (UnimplementedLlmCacheServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedLlmCacheServiceServer) InspectFileCache(context.Context, *InspectFileCacheRequest) (*InspectFileCacheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InspectFileCache not implemented")
+}
+func (UnimplementedLlmCacheServiceServer) InvalidateFileCache(context.Context, *InvalidateFileCacheRequest) (*InvalidateCacheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InvalidateFileCache not implemented")
+}
+func (UnimplementedLlmCacheServiceServer) InvalidatePromptVersionCache(context.Context, *InvalidatePromptVersionCacheRequest) (*InvalidateCacheResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InvalidatePromptVersionCache not implemented")
+}
+func (UnimplementedLlmCacheServiceServer) mustEmbedUnimplementedLlmCacheServiceServer() {}
+func (UnimplementedLlmCacheServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeLlmCacheServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LlmCacheServiceServer will
+// result in compilation errors.
+type UnsafeLlmCacheServiceServer interface {
+	mustEmbedUnimplementedLlmCacheServiceServer()
+}
+
+func RegisterLlmCacheServiceServer(s grpc.ServiceRegistrar, srv LlmCacheServiceServer) {
+	// If the following call panics, it indicates UnimplementedLlmCacheServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LlmCacheService_ServiceDesc, srv)
+}
+
+func _LlmCacheService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCacheServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCacheService_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCacheServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCacheService_InspectFileCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFileCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCacheServiceServer).InspectFileCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCacheService_InspectFileCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCacheServiceServer).InspectFileCache(ctx, req.(*InspectFileCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCacheService_InvalidateFileCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateFileCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCacheServiceServer).InvalidateFileCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCacheService_InvalidateFileCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCacheServiceServer).InvalidateFileCache(ctx, req.(*InvalidateFileCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LlmCacheService_InvalidatePromptVersionCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidatePromptVersionCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LlmCacheServiceServer).InvalidatePromptVersionCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LlmCacheService_InvalidatePromptVersionCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LlmCacheServiceServer).InvalidatePromptVersionCache(ctx, req.(*InvalidatePromptVersionCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LlmCacheService_ServiceDesc is the grpc.ServiceDesc for LlmCacheService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LlmCacheService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocr.LlmCacheService",
+	HandlerType: (*LlmCacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _LlmCacheService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "InspectFileCache",
+			Handler:    _LlmCacheService_InspectFileCache_Handler,
+		},
+		{
+			MethodName: "InvalidateFileCache",
+			Handler:    _LlmCacheService_InvalidateFileCache_Handler,
+		},
+		{
+			MethodName: "InvalidatePromptVersionCache",
+			Handler:    _LlmCacheService_InvalidatePromptVersionCache_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ocr/llm_cache.proto",
+}
@@ -7,6 +7,7 @@
 package ocr
 
 import (
+	_ "backend/gen/auth"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -114,13 +115,14 @@ var File_ocr_llm_debug_proto protoreflect.FileDescriptor
 
 const file_ocr_llm_debug_proto_rawDesc = "" +
 	"\n" +
-	"\x13ocr/llm_debug.proto\x12\x03ocr\x1a\x1cgoogle/api/annotations.proto\"*\n" +
+	"\x13ocr/llm_debug.proto\x12\x03ocr\x1a\x0fauth/auth.proto\x1a\x1cgoogle/api/annotations.proto\"*\n" +
 	"\rGetOcrRequest\x12\x19\n" +
 	"\bpage_key\x18\x01 \x01(\tR\apageKey\"+\n" +
 	"\x0eGetOcrResponse\x12\x19\n" +
-	"\bocr_text\x18\x01 \x01(\tR\aocrText2`\n" +
-	"\x0fLlmDebugService\x12M\n" +
-	"\x06GetOcr\x12\x12.ocr.GetOcrRequest\x1a\x13.ocr.GetOcrResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/_internal/llm/ocrBU\n" +
+	"\bocr_text\x18\x01 \x01(\tR\aocrText2o\n" +
+	"\x0fLlmDebugService\x12\\\n" +
+	"\x06GetOcr\x12\x12.ocr.GetOcrRequest\x1a\x13.ocr.GetOcrResponse\")\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02\x14\x12\x12/_internal/llm/ocrBU\n" +
 	"\acom.ocrB\rLlmDebugProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
 
 var (
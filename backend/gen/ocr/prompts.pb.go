@@ -0,0 +1,420 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: ocr/prompts.proto
+
+package ocr
+
+import (
+	_ "backend/gen/auth"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PromptVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Agent         string                 `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	System        string                 `protobuf:"bytes,4,opt,name=system,proto3" json:"system,omitempty"`
+	User          string                 `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptVersion) Reset() {
+	*x = PromptVersion{}
+	mi := &file_ocr_prompts_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptVersion) ProtoMessage() {}
+
+func (x *PromptVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_prompts_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptVersion.ProtoReflect.Descriptor instead.
+func (*PromptVersion) Descriptor() ([]byte, []int) {
+	return file_ocr_prompts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PromptVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListPromptVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptVersionsRequest) Reset() {
+	*x = ListPromptVersionsRequest{}
+	mi := &file_ocr_prompts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptVersionsRequest) ProtoMessage() {}
+
+func (x *ListPromptVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_prompts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_prompts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPromptVersionsRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+type ListPromptVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*PromptVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptVersionsResponse) Reset() {
+	*x = ListPromptVersionsResponse{}
+	mi := &file_ocr_prompts_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptVersionsResponse) ProtoMessage() {}
+
+func (x *ListPromptVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_prompts_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_ocr_prompts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPromptVersionsResponse) GetVersions() []*PromptVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type CreatePromptVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	System        string                 `protobuf:"bytes,3,opt,name=system,proto3" json:"system,omitempty"`
+	User          string                 `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptVersionRequest) Reset() {
+	*x = CreatePromptVersionRequest{}
+	mi := &file_ocr_prompts_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptVersionRequest) ProtoMessage() {}
+
+func (x *CreatePromptVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_prompts_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptVersionRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_prompts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePromptVersionRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *CreatePromptVersionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePromptVersionRequest) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *CreatePromptVersionRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ActivatePromptVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePromptVersionRequest) Reset() {
+	*x = ActivatePromptVersionRequest{}
+	mi := &file_ocr_prompts_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePromptVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePromptVersionRequest) ProtoMessage() {}
+
+func (x *ActivatePromptVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocr_prompts_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePromptVersionRequest.ProtoReflect.Descriptor instead.
+func (*ActivatePromptVersionRequest) Descriptor() ([]byte, []int) {
+	return file_ocr_prompts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivatePromptVersionRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *ActivatePromptVersionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_ocr_prompts_proto protoreflect.FileDescriptor
+
+const file_ocr_prompts_proto_rawDesc = "" +
+	"\n" +
+	"\x11ocr/prompts.proto\x12\x03ocr\x1a\x0fauth/auth.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xba\x01\n" +
+	"\rPromptVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05agent\x18\x02 \x01(\tR\x05agent\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06system\x18\x04 \x01(\tR\x06system\x12\x12\n" +
+	"\x04user\x18\x05 \x01(\tR\x04user\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\"1\n" +
+	"\x19ListPromptVersionsRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\"L\n" +
+	"\x1aListPromptVersionsResponse\x12.\n" +
+	"\bversions\x18\x01 \x03(\v2\x12.ocr.PromptVersionR\bversions\"\x80\x01\n" +
+	"\x1aCreatePromptVersionRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06system\x18\x03 \x01(\tR\x06system\x12\x12\n" +
+	"\x04user\x18\x04 \x01(\tR\x04user\"D\n" +
+	"\x1cActivatePromptVersionRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id2\xdb\x03\n" +
+	"\x0ePromptsService\x12\x95\x01\n" +
+	"\x12ListPromptVersions\x12\x1e.ocr.ListPromptVersionsRequest\x1a\x1f.ocr.ListPromptVersionsResponse\">\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02)\x12'/_internal/llm/prompts/{agent}/versions\x12\x8d\x01\n" +
+	"\x13CreatePromptVersion\x12\x1f.ocr.CreatePromptVersionRequest\x1a\x12.ocr.PromptVersion\"A\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x02,:\x01*\"'/_internal/llm/prompts/{agent}/versions\x12\xa0\x01\n" +
+	"\x15ActivatePromptVersion\x12!.ocr.ActivatePromptVersionRequest\x1a\x16.google.protobuf.Empty\"L\x82\xb5\x18\v\n" +
+	"\tllm:admin\x82\xd3\xe4\x93\x027\"5/_internal/llm/prompts/{agent}/versions/{id}/activateBT\n" +
+	"\acom.ocrB\fPromptsProtoP\x01Z\x0fbackend/gen/ocr\xa2\x02\x03OXX\xaa\x02\x03Ocr\xca\x02\x03Ocr\xe2\x02\x0fOcr\\GPBMetadata\xea\x02\x03Ocrb\x06proto3"
+
+var (
+	file_ocr_prompts_proto_rawDescOnce sync.Once
+	file_ocr_prompts_proto_rawDescData []byte
+)
+
+func file_ocr_prompts_proto_rawDescGZIP() []byte {
+	file_ocr_prompts_proto_rawDescOnce.Do(func() {
+		file_ocr_prompts_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ocr_prompts_proto_rawDesc), len(file_ocr_prompts_proto_rawDesc)))
+	})
+	return file_ocr_prompts_proto_rawDescData
+}
+
+var file_ocr_prompts_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ocr_prompts_proto_goTypes = []any{
+	(*PromptVersion)(nil),                // 0: ocr.PromptVersion
+	(*ListPromptVersionsRequest)(nil),    // 1: ocr.ListPromptVersionsRequest
+	(*ListPromptVersionsResponse)(nil),   // 2: ocr.ListPromptVersionsResponse
+	(*CreatePromptVersionRequest)(nil),   // 3: ocr.CreatePromptVersionRequest
+	(*ActivatePromptVersionRequest)(nil), // 4: ocr.ActivatePromptVersionRequest
+	(*emptypb.Empty)(nil),                // 5: google.protobuf.Empty
+}
+var file_ocr_prompts_proto_depIdxs = []int32{
+	0, // 0: ocr.ListPromptVersionsResponse.versions:type_name -> ocr.PromptVersion
+	1, // 1: ocr.PromptsService.ListPromptVersions:input_type -> ocr.ListPromptVersionsRequest
+	3, // 2: ocr.PromptsService.CreatePromptVersion:input_type -> ocr.CreatePromptVersionRequest
+	4, // 3: ocr.PromptsService.ActivatePromptVersion:input_type -> ocr.ActivatePromptVersionRequest
+	2, // 4: ocr.PromptsService.ListPromptVersions:output_type -> ocr.ListPromptVersionsResponse
+	0, // 5: ocr.PromptsService.CreatePromptVersion:output_type -> ocr.PromptVersion
+	5, // 6: ocr.PromptsService.ActivatePromptVersion:output_type -> google.protobuf.Empty
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ocr_prompts_proto_init() }
+func file_ocr_prompts_proto_init() {
+	if File_ocr_prompts_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ocr_prompts_proto_rawDesc), len(file_ocr_prompts_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ocr_prompts_proto_goTypes,
+		DependencyIndexes: file_ocr_prompts_proto_depIdxs,
+		MessageInfos:      file_ocr_prompts_proto_msgTypes,
+	}.Build()
+	File_ocr_prompts_proto = out.File
+	file_ocr_prompts_proto_goTypes = nil
+	file_ocr_prompts_proto_depIdxs = nil
+}
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: ocr/prompts.proto
+
+package ocr
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PromptsService_ListPromptVersions_FullMethodName    = "/ocr.PromptsService/ListPromptVersions"
+	PromptsService_CreatePromptVersion_FullMethodName   = "/ocr.PromptsService/CreatePromptVersion"
+	PromptsService_ActivatePromptVersion_FullMethodName = "/ocr.PromptsService/ActivatePromptVersion"
+)
+
+// PromptsServiceClient is the client API for PromptsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromptsServiceClient interface {
+	ListPromptVersions(ctx context.Context, in *ListPromptVersionsRequest, opts ...grpc.CallOption) (*ListPromptVersionsResponse, error)
+	CreatePromptVersion(ctx context.Context, in *CreatePromptVersionRequest, opts ...grpc.CallOption) (*PromptVersion, error)
+	ActivatePromptVersion(ctx context.Context, in *ActivatePromptVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type promptsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromptsServiceClient(cc grpc.ClientConnInterface) PromptsServiceClient {
+	return &promptsServiceClient{cc}
+}
+
+func (c *promptsServiceClient) ListPromptVersions(ctx context.Context, in *ListPromptVersionsRequest, opts ...grpc.CallOption) (*ListPromptVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptVersionsResponse)
+	err := c.cc.Invoke(ctx, PromptsService_ListPromptVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptsServiceClient) CreatePromptVersion(ctx context.Context, in *CreatePromptVersionRequest, opts ...grpc.CallOption) (*PromptVersion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptVersion)
+	err := c.cc.Invoke(ctx, PromptsService_CreatePromptVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptsServiceClient) ActivatePromptVersion(ctx context.Context, in *ActivatePromptVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PromptsService_ActivatePromptVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromptsServiceServer is the server API for PromptsService service.
+// All implementations must embed UnimplementedPromptsServiceServer
+// for forward compatibility.
+type PromptsServiceServer interface {
+	ListPromptVersions(context.Context, *ListPromptVersionsRequest) (*ListPromptVersionsResponse, error)
+	CreatePromptVersion(context.Context, *CreatePromptVersionRequest) (*PromptVersion, error)
+	ActivatePromptVersion(context.Context, *ActivatePromptVersionRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedPromptsServiceServer()
+}
+
+// UnimplementedPromptsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromptsServiceServer struct{}
+
+func (UnimplementedPromptsServiceServer) ListPromptVersions(context.Context, *ListPromptVersionsRequest) (*ListPromptVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPromptVersions not implemented")
+}
+func (UnimplementedPromptsServiceServer) CreatePromptVersion(context.Context, *CreatePromptVersionRequest) (*PromptVersion, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePromptVersion not implemented")
+}
+func (UnimplementedPromptsServiceServer) ActivatePromptVersion(context.Context, *ActivatePromptVersionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivatePromptVersion not implemented")
+}
+func (UnimplementedPromptsServiceServer) mustEmbedUnimplementedPromptsServiceServer() {}
+func (UnimplementedPromptsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePromptsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromptsServiceServer will
+// result in compilation errors.
+type UnsafePromptsServiceServer interface {
+	mustEmbedUnimplementedPromptsServiceServer()
+}
+
+func RegisterPromptsServiceServer(s grpc.ServiceRegistrar, srv PromptsServiceServer) {
+	// If the following call panics, it indicates UnimplementedPromptsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromptsService_ServiceDesc, srv)
+}
+
+func _PromptsService_ListPromptVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptsServiceServer).ListPromptVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptsService_ListPromptVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptsServiceServer).ListPromptVersions(ctx, req.(*ListPromptVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptsService_CreatePromptVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromptVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptsServiceServer).CreatePromptVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptsService_CreatePromptVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptsServiceServer).CreatePromptVersion(ctx, req.(*CreatePromptVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptsService_ActivatePromptVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivatePromptVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptsServiceServer).ActivatePromptVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptsService_ActivatePromptVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptsServiceServer).ActivatePromptVersion(ctx, req.(*ActivatePromptVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromptsService_ServiceDesc is the grpc.ServiceDesc for PromptsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromptsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocr.PromptsService",
+	HandlerType: (*PromptsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPromptVersions",
+			Handler:    _PromptsService_ListPromptVersions_Handler,
+		},
+		{
+			MethodName: "CreatePromptVersion",
+			Handler:    _PromptsService_CreatePromptVersion_Handler,
+		},
+		{
+			MethodName: "ActivatePromptVersion",
+			Handler:    _PromptsService_ActivatePromptVersion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ocr/prompts.proto",
+}
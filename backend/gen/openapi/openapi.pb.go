@@ -25,8 +25,18 @@ var File_openapi_openapi_proto protoreflect.FileDescriptor
 
 const file_openapi_openapi_proto_rawDesc = "" +
 	"\n" +
-	"\x15openapi/openapi.proto\x12\aopenapi\x1a.protoc-gen-openapiv2/options/annotations.protoB\xac\x01\x92A=\x12\x17\n" +
-	"\x10EDA Workshop Api2\x031.02\x10application/json:\x10application/json\n" +
+	"\x15openapi/openapi.proto\x12\aopenapi\x1a.protoc-gen-openapiv2/options/annotations.protoB\xb1\x02\x92A\xc1\x01\x12\x17\n" +
+	"\x10EDA Workshop Api2\x031.02\x10application/json:\x10application/jsonZf\n" +
+	"\x19\n" +
+	"\x06ApiKey\x12\x0f\b\x02\x1a\tX-Api-Key \x02\n" +
+	"I\n" +
+	"\x06Bearer\x12?\b\x02\x12*JWT bearer token, sent as \"Bearer <token>\"\x1a\rAuthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06ApiKey\x12\x00b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\n" +
 	"\vcom.openapiB\fOpenapiProtoP\x01Z\x13backend/gen/openapi\xa2\x02\x03OXX\xaa\x02\aOpenapi\xca\x02\aOpenapi\xe2\x02\x13Openapi\\GPBMetadata\xea\x02\aOpenapib\x06proto3"
 
 var file_openapi_openapi_proto_goTypes = []any{}
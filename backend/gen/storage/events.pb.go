@@ -22,11 +22,13 @@ const (
 )
 
 type FileUploadedEventData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileKey       string                 `protobuf:"bytes,2,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FileName           string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileKey            string                 `protobuf:"bytes,2,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	ExtractionSchemaId string                 `protobuf:"bytes,3,opt,name=extraction_schema_id,json=extractionSchemaId,proto3" json:"extraction_schema_id,omitempty"`
+	TenantId           string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *FileUploadedEventData) Reset() {
@@ -73,9 +75,24 @@ func (x *FileUploadedEventData) GetFileKey() string {
 	return ""
 }
 
+func (x *FileUploadedEventData) GetExtractionSchemaId() string {
+	if x != nil {
+		return x.ExtractionSchemaId
+	}
+	return ""
+}
+
+func (x *FileUploadedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 type FilesDeletedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileKeys      []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,16 +134,279 @@ func (x *FilesDeletedEventData) GetFileKeys() []string {
 	return nil
 }
 
+func (x *FilesDeletedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type FilesTrashedEventData struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FileKeys []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Orders trash and restore events of the same file
+	TrashedAt     string `protobuf:"bytes,3,opt,name=trashed_at,json=trashedAt,proto3" json:"trashed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilesTrashedEventData) Reset() {
+	*x = FilesTrashedEventData{}
+	mi := &file_storage_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilesTrashedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesTrashedEventData) ProtoMessage() {}
+
+func (x *FilesTrashedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesTrashedEventData.ProtoReflect.Descriptor instead.
+func (*FilesTrashedEventData) Descriptor() ([]byte, []int) {
+	return file_storage_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FilesTrashedEventData) GetFileKeys() []string {
+	if x != nil {
+		return x.FileKeys
+	}
+	return nil
+}
+
+func (x *FilesTrashedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FilesTrashedEventData) GetTrashedAt() string {
+	if x != nil {
+		return x.TrashedAt
+	}
+	return ""
+}
+
+type FilesRestoredEventData struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FileKeys []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Orders trash and restore events of the same file
+	RestoredAt    string `protobuf:"bytes,3,opt,name=restored_at,json=restoredAt,proto3" json:"restored_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilesRestoredEventData) Reset() {
+	*x = FilesRestoredEventData{}
+	mi := &file_storage_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilesRestoredEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesRestoredEventData) ProtoMessage() {}
+
+func (x *FilesRestoredEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesRestoredEventData.ProtoReflect.Descriptor instead.
+func (*FilesRestoredEventData) Descriptor() ([]byte, []int) {
+	return file_storage_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FilesRestoredEventData) GetFileKeys() []string {
+	if x != nil {
+		return x.FileKeys
+	}
+	return nil
+}
+
+func (x *FilesRestoredEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FilesRestoredEventData) GetRestoredAt() string {
+	if x != nil {
+		return x.RestoredAt
+	}
+	return ""
+}
+
+type FileUpdatedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FolderId      string                 `protobuf:"bytes,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DisplayTitle  string                 `protobuf:"bytes,7,opt,name=display_title,json=displayTitle,proto3" json:"display_title,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,8,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Language      string                 `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUpdatedEventData) Reset() {
+	*x = FileUpdatedEventData{}
+	mi := &file_storage_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUpdatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUpdatedEventData) ProtoMessage() {}
+
+func (x *FileUpdatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUpdatedEventData.ProtoReflect.Descriptor instead.
+func (*FileUpdatedEventData) Descriptor() ([]byte, []int) {
+	return file_storage_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FileUpdatedEventData) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+func (x *FileUpdatedEventData) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FileUpdatedEventData) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileUpdatedEventData) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *FileUpdatedEventData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FileUpdatedEventData) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *FileUpdatedEventData) GetDisplayTitle() string {
+	if x != nil {
+		return x.DisplayTitle
+	}
+	return ""
+}
+
+func (x *FileUpdatedEventData) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *FileUpdatedEventData) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 var File_storage_events_proto protoreflect.FileDescriptor
 
 const file_storage_events_proto_rawDesc = "" +
 	"\n" +
-	"\x14storage/events.proto\x12\astorage\"O\n" +
+	"\x14storage/events.proto\x12\astorage\"\x9e\x01\n" +
 	"\x15FileUploadedEventData\x12\x1b\n" +
 	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x19\n" +
-	"\bfile_key\x18\x02 \x01(\tR\afileKey\"4\n" +
+	"\bfile_key\x18\x02 \x01(\tR\afileKey\x120\n" +
+	"\x14extraction_schema_id\x18\x03 \x01(\tR\x12extractionSchemaId\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\"Q\n" +
 	"\x15FilesDeletedEventData\x12\x1b\n" +
-	"\tfile_keys\x18\x01 \x03(\tR\bfileKeysBk\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"p\n" +
+	"\x15FilesTrashedEventData\x12\x1b\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"trashed_at\x18\x03 \x01(\tR\ttrashedAt\"s\n" +
+	"\x16FilesRestoredEventData\x12\x1b\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vrestored_at\x18\x03 \x01(\tR\n" +
+	"restoredAt\"\x88\x03\n" +
+	"\x14FileUpdatedEventData\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfolder_id\x18\x04 \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12G\n" +
+	"\bmetadata\x18\x06 \x03(\v2+.storage.FileUpdatedEventData.MetadataEntryR\bmetadata\x12#\n" +
+	"\rdisplay_title\x18\a \x01(\tR\fdisplayTitle\x12#\n" +
+	"\rdocument_type\x18\b \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01Bk\n" +
 	"\vcom.storageB\vEventsProtoP\x01Z\x13backend/gen/storage\xa2\x02\x03SXX\xaa\x02\aStorage\xca\x02\aStorage\xe2\x02\x13Storage\\GPBMetadata\xea\x02\aStorageb\x06proto3"
 
 var (
@@ -141,17 +421,22 @@ func file_storage_events_proto_rawDescGZIP() []byte {
 	return file_storage_events_proto_rawDescData
 }
 
-var file_storage_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_storage_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_storage_events_proto_goTypes = []any{
-	(*FileUploadedEventData)(nil), // 0: storage.FileUploadedEventData
-	(*FilesDeletedEventData)(nil), // 1: storage.FilesDeletedEventData
+	(*FileUploadedEventData)(nil),  // 0: storage.FileUploadedEventData
+	(*FilesDeletedEventData)(nil),  // 1: storage.FilesDeletedEventData
+	(*FilesTrashedEventData)(nil),  // 2: storage.FilesTrashedEventData
+	(*FilesRestoredEventData)(nil), // 3: storage.FilesRestoredEventData
+	(*FileUpdatedEventData)(nil),   // 4: storage.FileUpdatedEventData
+	nil,                            // 5: storage.FileUpdatedEventData.MetadataEntry
 }
 var file_storage_events_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5, // 0: storage.FileUpdatedEventData.metadata:type_name -> storage.FileUpdatedEventData.MetadataEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_storage_events_proto_init() }
@@ -165,7 +450,7 @@ func file_storage_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storage_events_proto_rawDesc), len(file_storage_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
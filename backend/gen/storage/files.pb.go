@@ -7,6 +7,7 @@
 package storage
 
 import (
+	_ "backend/gen/auth"
 	core "backend/gen/core"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -26,6 +27,680 @@ const (
 )
 
 type GetFilesRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PageNumber   int32                  `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize     int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	DocumentType string                 `protobuf:"bytes,3,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	FolderId     string                 `protobuf:"bytes,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// Include the files of every subfolder of folder_id
+	IncludeSubfolders bool `protobuf:"varint,5,opt,name=include_subfolders,json=includeSubfolders,proto3" json:"include_subfolders,omitempty"`
+	// Files must have every tag
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Files must have every metadata entry
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Case-insensitive search in the file name and title
+	Query string `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	// RFC3339 lower bound of created_at, inclusive
+	CreatedAfter string `protobuf:"bytes,9,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// RFC3339 upper bound of created_at, exclusive
+	CreatedBefore string `protobuf:"bytes,10,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	MinSize       int64  `protobuf:"varint,11,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	MaxSize       int64  `protobuf:"varint,12,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// MIME types of the files
+	FileTypes []string `protobuf:"bytes,13,rep,name=file_types,json=fileTypes,proto3" json:"file_types,omitempty"`
+	// One of created_at, file_name or file_size, defaults to created_at
+	SortBy string `protobuf:"bytes,14,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// asc or desc, defaults to desc
+	SortOrder string `protobuf:"bytes,15,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Cursor returned by the previous page, page_number is ignored when set
+	Cursor string `protobuf:"bytes,16,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Skip counting total_items. It is never counted when paginating with a
+	// cursor.
+	SkipTotal     bool `protobuf:"varint,17,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilesRequest) Reset() {
+	*x = GetFilesRequest{}
+	mi := &file_storage_files_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesRequest) ProtoMessage() {}
+
+func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetFilesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetFilesRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetIncludeSubfolders() bool {
+	if x != nil {
+		return x.IncludeSubfolders
+	}
+	return false
+}
+
+func (x *GetFilesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetFileTypes() []string {
+	if x != nil {
+		return x.FileTypes
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type GetFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *core.Pagination       `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Files         []*File                `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilesResponse) Reset() {
+	*x = GetFilesResponse{}
+	mi := &file_storage_files_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesResponse) ProtoMessage() {}
+
+func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetFilesResponse) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFilesResponse) GetPagination() *core.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFilesResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	mi := &file_storage_files_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFileRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type GetFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Processing    *FileProcessing        `protobuf:"bytes,2,opt,name=processing,proto3" json:"processing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileResponse) Reset() {
+	*x = GetFileResponse{}
+	mi := &file_storage_files_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileResponse) ProtoMessage() {}
+
+func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
+func (*GetFileResponse) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFileResponse) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *GetFileResponse) GetProcessing() *FileProcessing {
+	if x != nil {
+		return x.Processing
+	}
+	return nil
+}
+
+type FileProcessing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pending until the pages are rendered, then processing, completed or
+	// failed when any page failed
+	Status         string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PageCount      int32  `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	ProcessedPages int32  `protobuf:"varint,3,opt,name=processed_pages,json=processedPages,proto3" json:"processed_pages,omitempty"`
+	FailedPages    int32  `protobuf:"varint,4,opt,name=failed_pages,json=failedPages,proto3" json:"failed_pages,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FileProcessing) Reset() {
+	*x = FileProcessing{}
+	mi := &file_storage_files_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileProcessing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileProcessing) ProtoMessage() {}
+
+func (x *FileProcessing) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileProcessing.ProtoReflect.Descriptor instead.
+func (*FileProcessing) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FileProcessing) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FileProcessing) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FileProcessing) GetProcessedPages() int32 {
+	if x != nil {
+		return x.ProcessedPages
+	}
+	return 0
+}
+
+func (x *FileProcessing) GetFailedPages() int32 {
+	if x != nil {
+		return x.FailedPages
+	}
+	return 0
+}
+
+// Data of the Server-Sent Events streamed by GET
+// /storage/files/{file_key}/events, named after the event type
+type FileEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FileKey string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	// 1-based, set for page events
+	PageNumber    int32 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageCount     int32 `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileEvent) Reset() {
+	*x = FileEvent{}
+	mi := &file_storage_files_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileEvent) ProtoMessage() {}
+
+func (x *FileEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileEvent.ProtoReflect.Descriptor instead.
+func (*FileEvent) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FileEvent) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+func (x *FileEvent) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *FileEvent) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+type UpdateFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	DisplayTitle  string                 `protobuf:"bytes,3,opt,name=display_title,json=displayTitle,proto3" json:"display_title,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,4,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFileRequest) Reset() {
+	*x = UpdateFileRequest{}
+	mi := &file_storage_files_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFileRequest) ProtoMessage() {}
+
+func (x *UpdateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFileRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateFileRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+func (x *UpdateFileRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UpdateFileRequest) GetDisplayTitle() string {
+	if x != nil {
+		return x.DisplayTitle
+	}
+	return ""
+}
+
+func (x *UpdateFileRequest) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *UpdateFileRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type DeleteFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKeys      []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilesRequest) Reset() {
+	*x = DeleteFilesRequest{}
+	mi := &file_storage_files_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesRequest) ProtoMessage() {}
+
+func (x *DeleteFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteFilesRequest) GetFileKeys() []string {
+	if x != nil {
+		return x.FileKeys
+	}
+	return nil
+}
+
+type RestoreFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileKeys      []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFilesRequest) Reset() {
+	*x = RestoreFilesRequest{}
+	mi := &file_storage_files_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFilesRequest) ProtoMessage() {}
+
+func (x *RestoreFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFilesRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFilesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreFilesRequest) GetFileKeys() []string {
+	if x != nil {
+		return x.FileKeys
+	}
+	return nil
+}
+
+type RestoreFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keys of the restored files, files not in the trash are skipped
+	FileKeys      []string `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFilesResponse) Reset() {
+	*x = RestoreFilesResponse{}
+	mi := &file_storage_files_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFilesResponse) ProtoMessage() {}
+
+func (x *RestoreFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFilesResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFilesResponse) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreFilesResponse) GetFileKeys() []string {
+	if x != nil {
+		return x.FileKeys
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageNumber    int32                  `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -33,21 +708,21 @@ type GetFilesRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFilesRequest) Reset() {
-	*x = GetFilesRequest{}
-	mi := &file_storage_files_proto_msgTypes[0]
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_storage_files_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilesRequest) String() string {
+func (x *ListTrashRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilesRequest) ProtoMessage() {}
+func (*ListTrashRequest) ProtoMessage() {}
 
-func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_files_proto_msgTypes[0]
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,26 +733,26 @@ func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFilesRequest.ProtoReflect.Descriptor instead.
-func (*GetFilesRequest) Descriptor() ([]byte, []int) {
-	return file_storage_files_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetFilesRequest) GetPageNumber() int32 {
+func (x *ListTrashRequest) GetPageNumber() int32 {
 	if x != nil {
 		return x.PageNumber
 	}
 	return 0
 }
 
-func (x *GetFilesRequest) GetPageSize() int32 {
+func (x *ListTrashRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type GetFilesResponse struct {
+type ListTrashResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *core.Pagination       `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Files         []*File                `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
@@ -85,21 +760,21 @@ type GetFilesResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFilesResponse) Reset() {
-	*x = GetFilesResponse{}
-	mi := &file_storage_files_proto_msgTypes[1]
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_storage_files_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilesResponse) String() string {
+func (x *ListTrashResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilesResponse) ProtoMessage() {}
+func (*ListTrashResponse) ProtoMessage() {}
 
-func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_files_proto_msgTypes[1]
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,47 +785,102 @@ func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFilesResponse.ProtoReflect.Descriptor instead.
-func (*GetFilesResponse) Descriptor() ([]byte, []int) {
-	return file_storage_files_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetFilesResponse) GetPagination() *core.Pagination {
+func (x *ListTrashResponse) GetPagination() *core.Pagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *GetFilesResponse) GetFiles() []*File {
+func (x *ListTrashResponse) GetFiles() []*File {
 	if x != nil {
 		return x.Files
 	}
 	return nil
 }
 
-type DeleteFilesRequest struct {
+type MoveFilesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FileKeys []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	// Empty moves the files to the root
+	FolderId      string `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFilesRequest) Reset() {
+	*x = MoveFilesRequest{}
+	mi := &file_storage_files_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFilesRequest) ProtoMessage() {}
+
+func (x *MoveFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFilesRequest.ProtoReflect.Descriptor instead.
+func (*MoveFilesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveFilesRequest) GetFileKeys() []string {
+	if x != nil {
+		return x.FileKeys
+	}
+	return nil
+}
+
+func (x *MoveFilesRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type TagFilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileKeys      []string               `protobuf:"bytes,1,rep,name=file_keys,json=fileKeys,proto3" json:"file_keys,omitempty"`
+	Add           []string               `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFilesRequest) Reset() {
-	*x = DeleteFilesRequest{}
-	mi := &file_storage_files_proto_msgTypes[2]
+func (x *TagFilesRequest) Reset() {
+	*x = TagFilesRequest{}
+	mi := &file_storage_files_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFilesRequest) String() string {
+func (x *TagFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFilesRequest) ProtoMessage() {}
+func (*TagFilesRequest) ProtoMessage() {}
 
-func (x *DeleteFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_files_proto_msgTypes[2]
+func (x *TagFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,32 +891,120 @@ func (x *DeleteFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFilesRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFilesRequest) Descriptor() ([]byte, []int) {
-	return file_storage_files_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TagFilesRequest.ProtoReflect.Descriptor instead.
+func (*TagFilesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeleteFilesRequest) GetFileKeys() []string {
+func (x *TagFilesRequest) GetFileKeys() []string {
 	if x != nil {
 		return x.FileKeys
 	}
 	return nil
 }
 
-type File struct {
+func (x *TagFilesRequest) GetAdd() []string {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *TagFilesRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type UpdateFileMetadataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileKey       string                 `protobuf:"bytes,2,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
-	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	FileType      string                 `protobuf:"bytes,4,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	Set           map[string]string      `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFileMetadataRequest) Reset() {
+	*x = UpdateFileMetadataRequest{}
+	mi := &file_storage_files_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFileMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFileMetadataRequest) ProtoMessage() {}
+
+func (x *UpdateFileMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_files_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFileMetadataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFileMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_storage_files_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateFileMetadataRequest) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+func (x *UpdateFileMetadataRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *UpdateFileMetadataRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type File struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FileName     string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileKey      string                 `protobuf:"bytes,2,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	FileSize     int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	FileType     string                 `protobuf:"bytes,4,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	CreatedAt    string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DocumentType string                 `protobuf:"bytes,6,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Language     string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	DisplayTitle string                 `protobuf:"bytes,8,opt,name=display_title,json=displayTitle,proto3" json:"display_title,omitempty"`
+	// Presigned URL of the JPEG preview of the first page, the object does not
+	// exist until the file is rendered
+	CoverUrl string `protobuf:"bytes,9,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	// SHA-256 of the uploaded content, equal for duplicate uploads
+	ContentHash string `protobuf:"bytes,10,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Set for files in the trash
+	DeletedAt string `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Empty for files in the root
+	FolderId      string            `protobuf:"bytes,12,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Tags          []string          `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_storage_files_proto_msgTypes[3]
+	mi := &file_storage_files_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +1016,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_files_proto_msgTypes[3]
+	mi := &file_storage_files_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +1029,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_storage_files_proto_rawDescGZIP(), []int{3}
+	return file_storage_files_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *File) GetFileName() string {
@@ -249,34 +1067,214 @@ func (x *File) GetCreatedAt() string {
 	return ""
 }
 
+func (x *File) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *File) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *File) GetDisplayTitle() string {
+	if x != nil {
+		return x.DisplayTitle
+	}
+	return ""
+}
+
+func (x *File) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *File) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *File) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *File) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *File) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *File) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 var File_storage_files_proto protoreflect.FileDescriptor
 
 const file_storage_files_proto_rawDesc = "" +
 	"\n" +
-	"\x13storage/files.proto\x12\astorage\x1a\x15core/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"O\n" +
+	"\x13storage/files.proto\x12\astorage\x1a\x0fauth/auth.proto\x1a\x15core/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xfb\x04\n" +
 	"\x0fGetFilesRequest\x12\x1f\n" +
 	"\vpage_number\x18\x01 \x01(\x05R\n" +
 	"pageNumber\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"i\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12#\n" +
+	"\rdocument_type\x18\x03 \x01(\tR\fdocumentType\x12\x1b\n" +
+	"\tfolder_id\x18\x04 \x01(\tR\bfolderId\x12-\n" +
+	"\x12include_subfolders\x18\x05 \x01(\bR\x11includeSubfolders\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12B\n" +
+	"\bmetadata\x18\a \x03(\v2&.storage.GetFilesRequest.MetadataEntryR\bmetadata\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12#\n" +
+	"\rcreated_after\x18\t \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\n" +
+	" \x01(\tR\rcreatedBefore\x12\x19\n" +
+	"\bmin_size\x18\v \x01(\x03R\aminSize\x12\x19\n" +
+	"\bmax_size\x18\f \x01(\x03R\amaxSize\x12\x1d\n" +
+	"\n" +
+	"file_types\x18\r \x03(\tR\tfileTypes\x12\x17\n" +
+	"\asort_by\x18\x0e \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x0f \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06cursor\x18\x10 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\x11 \x01(\bR\tskipTotal\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
 	"\x10GetFilesResponse\x120\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x10.core.PaginationR\n" +
 	"pagination\x12#\n" +
-	"\x05files\x18\x02 \x03(\v2\r.storage.FileR\x05files\"1\n" +
+	"\x05files\x18\x02 \x03(\v2\r.storage.FileR\x05files\"+\n" +
+	"\x0eGetFileRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\"m\n" +
+	"\x0fGetFileResponse\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.storage.FileR\x04file\x127\n" +
+	"\n" +
+	"processing\x18\x02 \x01(\v2\x17.storage.FileProcessingR\n" +
+	"processing\"\x93\x01\n" +
+	"\x0eFileProcessing\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x02 \x01(\x05R\tpageCount\x12'\n" +
+	"\x0fprocessed_pages\x18\x03 \x01(\x05R\x0eprocessedPages\x12!\n" +
+	"\ffailed_pages\x18\x04 \x01(\x05R\vfailedPages\"f\n" +
+	"\tFileEvent\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12\x1f\n" +
+	"\vpage_number\x18\x02 \x01(\x05R\n" +
+	"pageNumber\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x03 \x01(\x05R\tpageCount\"\xb1\x01\n" +
+	"\x11UpdateFileRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12#\n" +
+	"\rdisplay_title\x18\x03 \x01(\tR\fdisplayTitle\x12#\n" +
+	"\rdocument_type\x18\x04 \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\"1\n" +
 	"\x12DeleteFilesRequest\x12\x1b\n" +
-	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\"\x97\x01\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\"2\n" +
+	"\x13RestoreFilesRequest\x12\x1b\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\"3\n" +
+	"\x14RestoreFilesResponse\x12\x1b\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\"P\n" +
+	"\x10ListTrashRequest\x12\x1f\n" +
+	"\vpage_number\x18\x01 \x01(\x05R\n" +
+	"pageNumber\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"j\n" +
+	"\x11ListTrashResponse\x120\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x10.core.PaginationR\n" +
+	"pagination\x12#\n" +
+	"\x05files\x18\x02 \x03(\v2\r.storage.FileR\x05files\"L\n" +
+	"\x10MoveFilesRequest\x12\x1b\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\x12\x1b\n" +
+	"\tfolder_id\x18\x02 \x01(\tR\bfolderId\"X\n" +
+	"\x0fTagFilesRequest\x12\x1b\n" +
+	"\tfile_keys\x18\x01 \x03(\tR\bfileKeys\x12\x10\n" +
+	"\x03add\x18\x02 \x03(\tR\x03add\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\"\xc5\x01\n" +
+	"\x19UpdateFileMetadataRequest\x12\x19\n" +
+	"\bfile_key\x18\x01 \x01(\tR\afileKey\x12=\n" +
+	"\x03set\x18\x02 \x03(\v2+.storage.UpdateFileMetadataRequest.SetEntryR\x03set\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\x1a6\n" +
+	"\bSetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x04\n" +
 	"\x04File\x12\x1b\n" +
 	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x19\n" +
 	"\bfile_key\x18\x02 \x01(\tR\afileKey\x12\x1b\n" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
 	"\tfile_type\x18\x04 \x01(\tR\bfileType\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt2\xbf\x02\n" +
-	"\fFilesService\x12\x92\x01\n" +
-	"\bGetFiles\x12\x18.storage.GetFilesRequest\x1a\x19.storage.GetFilesResponse\"Q\x92A8\n" +
-	"\x05Files\x12\tGet Files\x1a$Retrieves a paginated list of files.\x82\xd3\xe4\x93\x02\x10\x12\x0e/storage/files\x12\x99\x01\n" +
-	"\vDeleteFiles\x12\x1b.storage.DeleteFilesRequest\x1a\x16.google.protobuf.Empty\"U\x92A<\n" +
-	"\x05Files\x12\fDelete Files\x1a%Deletes multiple files by their keys.\x82\xd3\xe4\x93\x02\x10*\x0e/storage/filesBj\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12#\n" +
+	"\rdocument_type\x18\x06 \x01(\tR\fdocumentType\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12#\n" +
+	"\rdisplay_title\x18\b \x01(\tR\fdisplayTitle\x12\x1b\n" +
+	"\tcover_url\x18\t \x01(\tR\bcoverUrl\x12!\n" +
+	"\fcontent_hash\x18\n" +
+	" \x01(\tR\vcontentHash\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\tR\tdeletedAt\x12\x1b\n" +
+	"\tfolder_id\x18\f \x01(\tR\bfolderId\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x127\n" +
+	"\bmetadata\x18\x0e \x03(\v2\x1b.storage.File.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xab\x0f\n" +
+	"\fFilesService\x12\xe4\x01\n" +
+	"\bGetFiles\x12\x18.storage.GetFilesRequest\x1a\x19.storage.GetFilesResponse\"\xa2\x01\x92Ay\n" +
+	"\x05Files\x12\tGet Files\x1aeRetrieves a paginated list of files, optionally filtered by document type, folder, tags and metadata.\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02\x10\x12\x0e/storage/files\x12\xbf\x01\n" +
+	"\aGetFile\x12\x17.storage.GetFileRequest\x1a\x18.storage.GetFileResponse\"\x80\x01\x92AL\n" +
+	"\x05Files\x12\bGet File\x1a9Retrieve a file with its processing status and page count\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02\x1b\x12\x19/storage/files/{file_key}\x12\xe8\x01\n" +
+	"\n" +
+	"UpdateFile\x12\x1a.storage.UpdateFileRequest\x1a\r.storage.File\"\xae\x01\x92Av\n" +
+	"\x05Files\x12\vUpdate File\x1a`Renames a file and edits its title, document type and language. Empty fields are left unchanged.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/storage/files/{file_key}\x12\xec\x01\n" +
+	"\vDeleteFiles\x12\x1b.storage.DeleteFilesRequest\x1a\x16.google.protobuf.Empty\"\xa7\x01\x92A|\n" +
+	"\x05Files\x12\fDelete Files\x1aeMoves multiple files to the trash by their keys. Trashed files are purged after the retention period.\x82\xb5\x18\x0e\n" +
+	"\ffiles:delete\x82\xd3\xe4\x93\x02\x10*\x0e/storage/files\x12\xd0\x01\n" +
+	"\fRestoreFiles\x12\x1c.storage.RestoreFilesRequest\x1a\x1d.storage.RestoreFilesResponse\"\x82\x01\x92AL\n" +
+	"\x05Files\x12\rRestore Files\x1a4Moves multiple files out of the trash by their keys.\x82\xb5\x18\x0e\n" +
+	"\ffiles:delete\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/storage/trash/restore\x12\xd5\x01\n" +
+	"\tListTrash\x12\x19.storage.ListTrashRequest\x1a\x1a.storage.ListTrashResponse\"\x90\x01\x92Ag\n" +
+	"\x05Files\x12\n" +
+	"List Trash\x1aRRetrieves a paginated list of the files in the trash, most recently deleted first.\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02\x10\x12\x0e/storage/trash\x12\xd3\x01\n" +
+	"\tMoveFiles\x12\x19.storage.MoveFilesRequest\x1a\x16.google.protobuf.Empty\"\x92\x01\x92A`\n" +
+	"\x05Files\x12\n" +
+	"Move Files\x1aKMoves multiple files into a folder, or to the root when no folder is given.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/storage/files/move\x12\xac\x01\n" +
+	"\bTagFiles\x12\x18.storage.TagFilesRequest\x1a\x16.google.protobuf.Empty\"n\x92A<\n" +
+	"\x05Files\x12\tTag Files\x1a(Adds and removes tags on multiple files.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/storage/files/tags\x12\xe7\x01\n" +
+	"\x12UpdateFileMetadata\x12\".storage.UpdateFileMetadataRequest\x1a\r.storage.File\"\x9d\x01\x92A\\\n" +
+	"\x05Files\x12\x14Update File Metadata\x1a=Sets and removes custom key-value metadata entries of a file.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02':\x01*2\"/storage/files/{file_key}/metadataBj\n" +
 	"\vcom.storageB\n" +
 	"FilesProtoP\x01Z\x13backend/gen/storage\xa2\x02\x03SXX\xaa\x02\aStorage\xca\x02\aStorage\xe2\x02\x13Storage\\GPBMetadata\xea\x02\aStorageb\x06proto3"
 
@@ -292,27 +1290,63 @@ func file_storage_files_proto_rawDescGZIP() []byte {
 	return file_storage_files_proto_rawDescData
 }
 
-var file_storage_files_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_storage_files_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_storage_files_proto_goTypes = []any{
-	(*GetFilesRequest)(nil),    // 0: storage.GetFilesRequest
-	(*GetFilesResponse)(nil),   // 1: storage.GetFilesResponse
-	(*DeleteFilesRequest)(nil), // 2: storage.DeleteFilesRequest
-	(*File)(nil),               // 3: storage.File
-	(*core.Pagination)(nil),    // 4: core.Pagination
-	(*emptypb.Empty)(nil),      // 5: google.protobuf.Empty
+	(*GetFilesRequest)(nil),           // 0: storage.GetFilesRequest
+	(*GetFilesResponse)(nil),          // 1: storage.GetFilesResponse
+	(*GetFileRequest)(nil),            // 2: storage.GetFileRequest
+	(*GetFileResponse)(nil),           // 3: storage.GetFileResponse
+	(*FileProcessing)(nil),            // 4: storage.FileProcessing
+	(*FileEvent)(nil),                 // 5: storage.FileEvent
+	(*UpdateFileRequest)(nil),         // 6: storage.UpdateFileRequest
+	(*DeleteFilesRequest)(nil),        // 7: storage.DeleteFilesRequest
+	(*RestoreFilesRequest)(nil),       // 8: storage.RestoreFilesRequest
+	(*RestoreFilesResponse)(nil),      // 9: storage.RestoreFilesResponse
+	(*ListTrashRequest)(nil),          // 10: storage.ListTrashRequest
+	(*ListTrashResponse)(nil),         // 11: storage.ListTrashResponse
+	(*MoveFilesRequest)(nil),          // 12: storage.MoveFilesRequest
+	(*TagFilesRequest)(nil),           // 13: storage.TagFilesRequest
+	(*UpdateFileMetadataRequest)(nil), // 14: storage.UpdateFileMetadataRequest
+	(*File)(nil),                      // 15: storage.File
+	nil,                               // 16: storage.GetFilesRequest.MetadataEntry
+	nil,                               // 17: storage.UpdateFileMetadataRequest.SetEntry
+	nil,                               // 18: storage.File.MetadataEntry
+	(*core.Pagination)(nil),           // 19: core.Pagination
+	(*emptypb.Empty)(nil),             // 20: google.protobuf.Empty
 }
 var file_storage_files_proto_depIdxs = []int32{
-	4, // 0: storage.GetFilesResponse.pagination:type_name -> core.Pagination
-	3, // 1: storage.GetFilesResponse.files:type_name -> storage.File
-	0, // 2: storage.FilesService.GetFiles:input_type -> storage.GetFilesRequest
-	2, // 3: storage.FilesService.DeleteFiles:input_type -> storage.DeleteFilesRequest
-	1, // 4: storage.FilesService.GetFiles:output_type -> storage.GetFilesResponse
-	5, // 5: storage.FilesService.DeleteFiles:output_type -> google.protobuf.Empty
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	16, // 0: storage.GetFilesRequest.metadata:type_name -> storage.GetFilesRequest.MetadataEntry
+	19, // 1: storage.GetFilesResponse.pagination:type_name -> core.Pagination
+	15, // 2: storage.GetFilesResponse.files:type_name -> storage.File
+	15, // 3: storage.GetFileResponse.file:type_name -> storage.File
+	4,  // 4: storage.GetFileResponse.processing:type_name -> storage.FileProcessing
+	19, // 5: storage.ListTrashResponse.pagination:type_name -> core.Pagination
+	15, // 6: storage.ListTrashResponse.files:type_name -> storage.File
+	17, // 7: storage.UpdateFileMetadataRequest.set:type_name -> storage.UpdateFileMetadataRequest.SetEntry
+	18, // 8: storage.File.metadata:type_name -> storage.File.MetadataEntry
+	0,  // 9: storage.FilesService.GetFiles:input_type -> storage.GetFilesRequest
+	2,  // 10: storage.FilesService.GetFile:input_type -> storage.GetFileRequest
+	6,  // 11: storage.FilesService.UpdateFile:input_type -> storage.UpdateFileRequest
+	7,  // 12: storage.FilesService.DeleteFiles:input_type -> storage.DeleteFilesRequest
+	8,  // 13: storage.FilesService.RestoreFiles:input_type -> storage.RestoreFilesRequest
+	10, // 14: storage.FilesService.ListTrash:input_type -> storage.ListTrashRequest
+	12, // 15: storage.FilesService.MoveFiles:input_type -> storage.MoveFilesRequest
+	13, // 16: storage.FilesService.TagFiles:input_type -> storage.TagFilesRequest
+	14, // 17: storage.FilesService.UpdateFileMetadata:input_type -> storage.UpdateFileMetadataRequest
+	1,  // 18: storage.FilesService.GetFiles:output_type -> storage.GetFilesResponse
+	3,  // 19: storage.FilesService.GetFile:output_type -> storage.GetFileResponse
+	15, // 20: storage.FilesService.UpdateFile:output_type -> storage.File
+	20, // 21: storage.FilesService.DeleteFiles:output_type -> google.protobuf.Empty
+	9,  // 22: storage.FilesService.RestoreFiles:output_type -> storage.RestoreFilesResponse
+	11, // 23: storage.FilesService.ListTrash:output_type -> storage.ListTrashResponse
+	20, // 24: storage.FilesService.MoveFiles:output_type -> google.protobuf.Empty
+	20, // 25: storage.FilesService.TagFiles:output_type -> google.protobuf.Empty
+	15, // 26: storage.FilesService.UpdateFileMetadata:output_type -> storage.File
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_storage_files_proto_init() }
@@ -326,7 +1360,7 @@ func file_storage_files_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storage_files_proto_rawDesc), len(file_storage_files_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,8 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FilesService_GetFiles_FullMethodName    = "/storage.FilesService/GetFiles"
-	FilesService_DeleteFiles_FullMethodName = "/storage.FilesService/DeleteFiles"
+	FilesService_GetFiles_FullMethodName           = "/storage.FilesService/GetFiles"
+	FilesService_GetFile_FullMethodName            = "/storage.FilesService/GetFile"
+	FilesService_UpdateFile_FullMethodName         = "/storage.FilesService/UpdateFile"
+	FilesService_DeleteFiles_FullMethodName        = "/storage.FilesService/DeleteFiles"
+	FilesService_RestoreFiles_FullMethodName       = "/storage.FilesService/RestoreFiles"
+	FilesService_ListTrash_FullMethodName          = "/storage.FilesService/ListTrash"
+	FilesService_MoveFiles_FullMethodName          = "/storage.FilesService/MoveFiles"
+	FilesService_TagFiles_FullMethodName           = "/storage.FilesService/TagFiles"
+	FilesService_UpdateFileMetadata_FullMethodName = "/storage.FilesService/UpdateFileMetadata"
 )
 
 // FilesServiceClient is the client API for FilesService service.
@@ -29,7 +36,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FilesServiceClient interface {
 	GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (*GetFilesResponse, error)
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
+	UpdateFile(ctx context.Context, in *UpdateFileRequest, opts ...grpc.CallOption) (*File, error)
 	DeleteFiles(ctx context.Context, in *DeleteFilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreFiles(ctx context.Context, in *RestoreFilesRequest, opts ...grpc.CallOption) (*RestoreFilesResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	MoveFiles(ctx context.Context, in *MoveFilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TagFiles(ctx context.Context, in *TagFilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateFileMetadata(ctx context.Context, in *UpdateFileMetadataRequest, opts ...grpc.CallOption) (*File, error)
 }
 
 type filesServiceClient struct {
@@ -50,6 +64,26 @@ func (c *filesServiceClient) GetFiles(ctx context.Context, in *GetFilesRequest,
 	return out, nil
 }
 
+func (c *filesServiceClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileResponse)
+	err := c.cc.Invoke(ctx, FilesService_GetFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) UpdateFile(ctx context.Context, in *UpdateFileRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, FilesService_UpdateFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *filesServiceClient) DeleteFiles(ctx context.Context, in *DeleteFilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -60,12 +94,69 @@ func (c *filesServiceClient) DeleteFiles(ctx context.Context, in *DeleteFilesReq
 	return out, nil
 }
 
+func (c *filesServiceClient) RestoreFiles(ctx context.Context, in *RestoreFilesRequest, opts ...grpc.CallOption) (*RestoreFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFilesResponse)
+	err := c.cc.Invoke(ctx, FilesService_RestoreFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, FilesService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) MoveFiles(ctx context.Context, in *MoveFilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FilesService_MoveFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) TagFiles(ctx context.Context, in *TagFilesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FilesService_TagFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesServiceClient) UpdateFileMetadata(ctx context.Context, in *UpdateFileMetadataRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, FilesService_UpdateFileMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FilesServiceServer is the server API for FilesService service.
 // All implementations must embed UnimplementedFilesServiceServer
 // for forward compatibility.
 type FilesServiceServer interface {
 	GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error)
+	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
+	UpdateFile(context.Context, *UpdateFileRequest) (*File, error)
 	DeleteFiles(context.Context, *DeleteFilesRequest) (*emptypb.Empty, error)
+	RestoreFiles(context.Context, *RestoreFilesRequest) (*RestoreFilesResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	MoveFiles(context.Context, *MoveFilesRequest) (*emptypb.Empty, error)
+	TagFiles(context.Context, *TagFilesRequest) (*emptypb.Empty, error)
+	UpdateFileMetadata(context.Context, *UpdateFileMetadataRequest) (*File, error)
 	mustEmbedUnimplementedFilesServiceServer()
 }
 
@@ -79,9 +170,30 @@ type UnimplementedFilesServiceServer struct{}
 func (UnimplementedFilesServiceServer) GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFiles not implemented")
 }
+func (UnimplementedFilesServiceServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedFilesServiceServer) UpdateFile(context.Context, *UpdateFileRequest) (*File, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFile not implemented")
+}
 func (UnimplementedFilesServiceServer) DeleteFiles(context.Context, *DeleteFilesRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteFiles not implemented")
 }
+func (UnimplementedFilesServiceServer) RestoreFiles(context.Context, *RestoreFilesRequest) (*RestoreFilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreFiles not implemented")
+}
+func (UnimplementedFilesServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedFilesServiceServer) MoveFiles(context.Context, *MoveFilesRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveFiles not implemented")
+}
+func (UnimplementedFilesServiceServer) TagFiles(context.Context, *TagFilesRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method TagFiles not implemented")
+}
+func (UnimplementedFilesServiceServer) UpdateFileMetadata(context.Context, *UpdateFileMetadataRequest) (*File, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFileMetadata not implemented")
+}
 func (UnimplementedFilesServiceServer) mustEmbedUnimplementedFilesServiceServer() {}
 func (UnimplementedFilesServiceServer) testEmbeddedByValue()                      {}
 
@@ -121,6 +233,42 @@ func _FilesService_GetFiles_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FilesService_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_UpdateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).UpdateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_UpdateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).UpdateFile(ctx, req.(*UpdateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FilesService_DeleteFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteFilesRequest)
 	if err := dec(in); err != nil {
@@ -139,6 +287,96 @@ func _FilesService_DeleteFiles_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FilesService_RestoreFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).RestoreFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_RestoreFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).RestoreFiles(ctx, req.(*RestoreFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_MoveFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).MoveFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_MoveFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).MoveFiles(ctx, req.(*MoveFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_TagFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).TagFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_TagFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).TagFiles(ctx, req.(*TagFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilesService_UpdateFileMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFileMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesServiceServer).UpdateFileMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilesService_UpdateFileMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesServiceServer).UpdateFileMetadata(ctx, req.(*UpdateFileMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FilesService_ServiceDesc is the grpc.ServiceDesc for FilesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,10 +388,38 @@ var FilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFiles",
 			Handler:    _FilesService_GetFiles_Handler,
 		},
+		{
+			MethodName: "GetFile",
+			Handler:    _FilesService_GetFile_Handler,
+		},
+		{
+			MethodName: "UpdateFile",
+			Handler:    _FilesService_UpdateFile_Handler,
+		},
 		{
 			MethodName: "DeleteFiles",
 			Handler:    _FilesService_DeleteFiles_Handler,
 		},
+		{
+			MethodName: "RestoreFiles",
+			Handler:    _FilesService_RestoreFiles_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _FilesService_ListTrash_Handler,
+		},
+		{
+			MethodName: "MoveFiles",
+			Handler:    _FilesService_MoveFiles_Handler,
+		},
+		{
+			MethodName: "TagFiles",
+			Handler:    _FilesService_TagFiles_Handler,
+		},
+		{
+			MethodName: "UpdateFileMetadata",
+			Handler:    _FilesService_UpdateFileMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storage/files.proto",
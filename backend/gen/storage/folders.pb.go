@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: storage/folders.proto
+
+package storage
+
+import (
+	_ "backend/gen/auth"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Folder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty for root folders
+	ParentId      string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt     string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_storage_folders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Folder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Folder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Folder) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_storage_folders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFolderRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type GetFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFoldersRequest) Reset() {
+	*x = GetFoldersRequest{}
+	mi := &file_storage_folders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoldersRequest) ProtoMessage() {}
+
+func (x *GetFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoldersRequest.ProtoReflect.Descriptor instead.
+func (*GetFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFoldersRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type GetFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*Folder              `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFoldersResponse) Reset() {
+	*x = GetFoldersResponse{}
+	mi := &file_storage_folders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoldersResponse) ProtoMessage() {}
+
+func (x *GetFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoldersResponse.ProtoReflect.Descriptor instead.
+func (*GetFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFoldersResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type GetFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolderRequest) Reset() {
+	*x = GetFolderRequest{}
+	mi := &file_storage_folders_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolderRequest) ProtoMessage() {}
+
+func (x *GetFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolderRequest.ProtoReflect.Descriptor instead.
+func (*GetFolderRequest) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty moves the folder to the root
+	ParentId      string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFolderRequest) Reset() {
+	*x = UpdateFolderRequest{}
+	mi := &file_storage_folders_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFolderRequest) ProtoMessage() {}
+
+func (x *UpdateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFolderRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type DeleteFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	mi := &file_storage_folders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_folders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_storage_folders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_storage_folders_proto protoreflect.FileDescriptor
+
+const file_storage_folders_proto_rawDesc = "" +
+	"\n" +
+	"\x15storage/folders.proto\x12\astorage\x1a\x0fauth/auth.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x87\x01\n" +
+	"\x06Folder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"F\n" +
+	"\x13CreateFolderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"0\n" +
+	"\x11GetFoldersRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\"?\n" +
+	"\x12GetFoldersResponse\x12)\n" +
+	"\afolders\x18\x01 \x03(\v2\x0f.storage.FolderR\afolders\"\"\n" +
+	"\x10GetFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x13UpdateFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\"%\n" +
+	"\x13DeleteFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xfb\a\n" +
+	"\x0eFoldersService\x12\xcb\x01\n" +
+	"\fCreateFolder\x12\x1c.storage.CreateFolderRequest\x1a\x0f.storage.Folder\"\x8b\x01\x92A\\\n" +
+	"\aFolders\x12\rCreate Folder\x1aBCreates a folder, nested in another folder when a parent is given.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/storage/folders\x12\xdd\x01\n" +
+	"\n" +
+	"GetFolders\x12\x1a.storage.GetFoldersRequest\x1a\x1b.storage.GetFoldersResponse\"\x95\x01\x92Aj\n" +
+	"\aFolders\x12\vGet Folders\x1aRRetrieves the subfolders of a folder, or the root folders when no parent is given.\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02\x12\x12\x10/storage/folders\x12\x9d\x01\n" +
+	"\tGetFolder\x12\x19.storage.GetFolderRequest\x1a\x0f.storage.Folder\"d\x92A4\n" +
+	"\aFolders\x12\n" +
+	"Get Folder\x1a\x1dRetrieves a folder by its ID.\x82\xb5\x18\f\n" +
+	"\n" +
+	"files:read\x82\xd3\xe4\x93\x02\x17\x12\x15/storage/folders/{id}\x12\xbe\x01\n" +
+	"\fUpdateFolder\x12\x1c.storage.UpdateFolderRequest\x1a\x0f.storage.Folder\"\x7f\x92AK\n" +
+	"\aFolders\x12\rUpdate Folder\x1a1Renames a folder or moves it into another folder.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/storage/folders/{id}\x12\xd9\x01\n" +
+	"\fDeleteFolder\x12\x1c.storage.DeleteFolderRequest\x1a\x16.google.protobuf.Empty\"\x92\x01\x92Aa\n" +
+	"\aFolders\x12\rDelete Folder\x1aGDeletes a folder and its subfolders. Their files are moved to the root.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x17*\x15/storage/folders/{id}Bl\n" +
+	"\vcom.storageB\fFoldersProtoP\x01Z\x13backend/gen/storage\xa2\x02\x03SXX\xaa\x02\aStorage\xca\x02\aStorage\xe2\x02\x13Storage\\GPBMetadata\xea\x02\aStorageb\x06proto3"
+
+var (
+	file_storage_folders_proto_rawDescOnce sync.Once
+	file_storage_folders_proto_rawDescData []byte
+)
+
+func file_storage_folders_proto_rawDescGZIP() []byte {
+	file_storage_folders_proto_rawDescOnce.Do(func() {
+		file_storage_folders_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_storage_folders_proto_rawDesc), len(file_storage_folders_proto_rawDesc)))
+	})
+	return file_storage_folders_proto_rawDescData
+}
+
+var file_storage_folders_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_storage_folders_proto_goTypes = []any{
+	(*Folder)(nil),              // 0: storage.Folder
+	(*CreateFolderRequest)(nil), // 1: storage.CreateFolderRequest
+	(*GetFoldersRequest)(nil),   // 2: storage.GetFoldersRequest
+	(*GetFoldersResponse)(nil),  // 3: storage.GetFoldersResponse
+	(*GetFolderRequest)(nil),    // 4: storage.GetFolderRequest
+	(*UpdateFolderRequest)(nil), // 5: storage.UpdateFolderRequest
+	(*DeleteFolderRequest)(nil), // 6: storage.DeleteFolderRequest
+	(*emptypb.Empty)(nil),       // 7: google.protobuf.Empty
+}
+var file_storage_folders_proto_depIdxs = []int32{
+	0, // 0: storage.GetFoldersResponse.folders:type_name -> storage.Folder
+	1, // 1: storage.FoldersService.CreateFolder:input_type -> storage.CreateFolderRequest
+	2, // 2: storage.FoldersService.GetFolders:input_type -> storage.GetFoldersRequest
+	4, // 3: storage.FoldersService.GetFolder:input_type -> storage.GetFolderRequest
+	5, // 4: storage.FoldersService.UpdateFolder:input_type -> storage.UpdateFolderRequest
+	6, // 5: storage.FoldersService.DeleteFolder:input_type -> storage.DeleteFolderRequest
+	0, // 6: storage.FoldersService.CreateFolder:output_type -> storage.Folder
+	3, // 7: storage.FoldersService.GetFolders:output_type -> storage.GetFoldersResponse
+	0, // 8: storage.FoldersService.GetFolder:output_type -> storage.Folder
+	0, // 9: storage.FoldersService.UpdateFolder:output_type -> storage.Folder
+	7, // 10: storage.FoldersService.DeleteFolder:output_type -> google.protobuf.Empty
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_storage_folders_proto_init() }
+func file_storage_folders_proto_init() {
+	if File_storage_folders_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storage_folders_proto_rawDesc), len(file_storage_folders_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_storage_folders_proto_goTypes,
+		DependencyIndexes: file_storage_folders_proto_depIdxs,
+		MessageInfos:      file_storage_folders_proto_msgTypes,
+	}.Build()
+	File_storage_folders_proto = out.File
+	file_storage_folders_proto_goTypes = nil
+	file_storage_folders_proto_depIdxs = nil
+}
@@ -7,6 +7,7 @@
 package storage
 
 import (
+	_ "backend/gen/auth"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -77,11 +78,12 @@ func (x *GetUploadUrlResponse) GetFileKey() string {
 }
 
 type ConfirmFileUploadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileKey       string                 `protobuf:"bytes,2,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FileName           string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileKey            string                 `protobuf:"bytes,2,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	ExtractionSchemaId string                 `protobuf:"bytes,3,opt,name=extraction_schema_id,json=extractionSchemaId,proto3" json:"extraction_schema_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ConfirmFileUploadRequest) Reset() {
@@ -128,6 +130,13 @@ func (x *ConfirmFileUploadRequest) GetFileKey() string {
 	return ""
 }
 
+func (x *ConfirmFileUploadRequest) GetExtractionSchemaId() string {
+	if x != nil {
+		return x.ExtractionSchemaId
+	}
+	return ""
+}
+
 type GetFileUrlRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileKey       string                 `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
@@ -220,26 +229,31 @@ var File_storage_storage_proto protoreflect.FileDescriptor
 
 const file_storage_storage_proto_rawDesc = "" +
 	"\n" +
-	"\x15storage/storage.proto\x12\astorage\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"P\n" +
+	"\x15storage/storage.proto\x12\astorage\x1a\x0fauth/auth.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"P\n" +
 	"\x14GetUploadUrlResponse\x12\x1d\n" +
 	"\n" +
 	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x19\n" +
-	"\bfile_key\x18\x02 \x01(\tR\afileKey\"R\n" +
+	"\bfile_key\x18\x02 \x01(\tR\afileKey\"\x84\x01\n" +
 	"\x18ConfirmFileUploadRequest\x12\x1b\n" +
 	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x19\n" +
-	"\bfile_key\x18\x02 \x01(\tR\afileKey\".\n" +
+	"\bfile_key\x18\x02 \x01(\tR\afileKey\x120\n" +
+	"\x14extraction_schema_id\x18\x03 \x01(\tR\x12extractionSchemaId\".\n" +
 	"\x11GetFileUrlRequest\x12\x19\n" +
 	"\bfile_key\x18\x01 \x01(\tR\afileKey\"/\n" +
 	"\x12GetFileUrlResponse\x12\x19\n" +
-	"\bfile_url\x18\x01 \x01(\tR\afileUrl2\xbd\x04\n" +
-	"\x0eStorageService\x12\xaf\x01\n" +
-	"\fGetUploadUrl\x12\x16.google.protobuf.Empty\x1a\x1d.storage.GetUploadUrlResponse\"h\x92AJ\n" +
-	"\aStorage\x12\x0eGet Upload URL\x1a/Generates a pre-signed URL for uploading files.\x82\xd3\xe4\x93\x02\x15\x12\x13/storage/upload-url\x12\xbc\x01\n" +
-	"\x11ConfirmFileUpload\x12!.storage.ConfirmFileUploadRequest\x1a\x16.google.protobuf.Empty\"l\x92AG\n" +
-	"\aStorage\x12\x13Confirm File Upload\x1a'Confirms that a file has been uploaded.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/storage/confirm-upload\x12\xb9\x01\n" +
+	"\bfile_url\x18\x01 \x01(\tR\afileUrl2\xf0\x04\n" +
+	"\x0eStorageService\x12\xc0\x01\n" +
+	"\fGetUploadUrl\x12\x16.google.protobuf.Empty\x1a\x1d.storage.GetUploadUrlResponse\"y\x92AJ\n" +
+	"\aStorage\x12\x0eGet Upload URL\x1a/Generates a pre-signed URL for uploading files.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x15\x12\x13/storage/upload-url\x12\xcd\x01\n" +
+	"\x11ConfirmFileUpload\x12!.storage.ConfirmFileUploadRequest\x1a\x16.google.protobuf.Empty\"}\x92AG\n" +
+	"\aStorage\x12\x13Confirm File Upload\x1a'Confirms that a file has been uploaded.\x82\xb5\x18\r\n" +
+	"\vfiles:write\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/storage/confirm-upload\x12\xca\x01\n" +
+	"\n" +
+	"GetFileUrl\x12\x1a.storage.GetFileUrlRequest\x1a\x1b.storage.GetFileUrlResponse\"\x82\x01\x92AK\n" +
+	"\aStorage\x12\fGet File URL\x1a2Retrieves a pre-signed URL for downloading a file.\x82\xb5\x18\f\n" +
 	"\n" +
-	"GetFileUrl\x12\x1a.storage.GetFileUrlRequest\x1a\x1b.storage.GetFileUrlResponse\"r\x92AK\n" +
-	"\aStorage\x12\fGet File URL\x1a2Retrieves a pre-signed URL for downloading a file.\x82\xd3\xe4\x93\x02\x1e\x12\x1c/storage/file-url/{file_key}Bl\n" +
+	"files:read\x82\xd3\xe4\x93\x02\x1e\x12\x1c/storage/file-url/{file_key}Bl\n" +
 	"\vcom.storageB\fStorageProtoP\x01Z\x13backend/gen/storage\xa2\x02\x03SXX\xaa\x02\aStorage\xca\x02\aStorage\xe2\x02\x13Storage\\GPBMetadata\xea\x02\aStorageb\x06proto3"
 
 var (
@@ -0,0 +1,79 @@
+package audit
+
+import (
+	auditdb "backend/internal/audit/db"
+	"backend/internal/audit/events"
+	"backend/internal/infrastructure/nats"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type AuditEventRecordedConsumer struct {
+	*nats.NatsConsumer[*events.AuditEventRecordedEvent]
+	db *auditdb.Queries
+}
+
+func NewAuditEventRecordedConsumer(
+	js jetstream.JetStream,
+	db *auditdb.Queries,
+) *AuditEventRecordedConsumer {
+	name := "audit_event_recorded_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &AuditEventRecordedConsumer{
+		db: db,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.AUDIT_CHANNEL,
+		events.AUDIT_EVENT_RECORDED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewAuditEventRecordedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "Audit Event Recorded Event Consumer",
+			FilterSubject: events.AUDIT_EVENT_RECORDED_EVENT,
+		},
+	)
+
+	return consumer
+}
+
+func (c *AuditEventRecordedConsumer) handler(
+	ctx context.Context,
+	event *events.AuditEventRecordedEvent,
+) error {
+	occurredAt, err := time.Parse(time.RFC3339Nano, event.Payload.OccurredAt)
+	if err != nil {
+		return err
+	}
+
+	// Redelivered events keep the row stored first
+	return c.db.CreateAuditEvent(ctx, auditdb.CreateAuditEventParams{
+		ID: pgtype.UUID{
+			Bytes: event.Id,
+			Valid: true,
+		},
+		TenantID:     event.Payload.TenantId,
+		Actor:        event.Payload.Actor,
+		AuthMethod:   event.Payload.AuthMethod,
+		Action:       event.Payload.Action,
+		ResourceType: event.Payload.ResourceType,
+		ResourceID:   event.Payload.ResourceId,
+		TraceID:      event.Payload.TraceId,
+		ClientIp:     event.Payload.ClientIp,
+		OccurredAt: pgtype.Timestamptz{
+			Time:  occurredAt,
+			Valid: true,
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"backend/internal/audit/events"
+	"backend/internal/infrastructure/nats"
+	"fmt"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type AuditProducer struct {
+	*nats.NatsProducer
+}
+
+func NewAuditProducer(
+	js jetstream.JetStream,
+) *AuditProducer {
+	name := "audit_producer"
+
+	return &AuditProducer{
+		NatsProducer: nats.NewNatsProducer(
+			// Producer Name
+			name,
+			// Channel Name
+			events.AUDIT_CHANNEL,
+			// JetStream Context
+			js,
+			// Stream Configuration
+			jetstream.StreamConfig{
+				Name:        nats.StreamName(events.AUDIT_CHANNEL),
+				Description: "Audit Event Stream",
+				Subjects:    []string{fmt.Sprintf("%s.>", events.AUDIT_CHANNEL)},
+			},
+		),
+	}
+}
@@ -0,0 +1,131 @@
+package audit
+
+import (
+	auditpb "backend/gen/audit"
+	"backend/gen/core"
+	auditdb "backend/internal/audit/db"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/service"
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuditService struct {
+	auditpb.UnimplementedAuditServiceServer
+	db *auditdb.Queries
+}
+
+var _ auditpb.AuditServiceServer = (*AuditService)(nil)
+var _ service.Service = (*AuditService)(nil)
+
+func NewAuditService(
+	db *auditdb.Queries,
+) *AuditService {
+	return &AuditService{
+		db: db,
+	}
+}
+
+// GetAuditEvents implements auditpb.AuditServiceServer.
+func (s *AuditService) GetAuditEvents(
+	ctx context.Context,
+	req *auditpb.GetAuditEventsRequest,
+) (*auditpb.GetAuditEventsResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := max(limit*(req.PageNumber-1), 0)
+
+	from, err := timeFilter("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := timeFilter("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.GetAuditEvents(ctx, auditdb.GetAuditEventsParams{
+		TenantID:     auth.Tenant(ctx),
+		Actor:        textFilter(req.Actor),
+		Action:       textFilter(req.Action),
+		ResourceType: textFilter(req.ResourceType),
+		ResourceID:   textFilter(req.ResourceId),
+		From:         from,
+		To:           to,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	auditEvents := make([]*auditpb.AuditEvent, len(result))
+	for i, event := range result {
+		auditEvents[i] = &auditpb.AuditEvent{
+			Id:           event.ID.String(),
+			Actor:        event.Actor,
+			AuthMethod:   event.AuthMethod,
+			Action:       event.Action,
+			ResourceType: event.ResourceType,
+			ResourceId:   event.ResourceID,
+			TraceId:      event.TraceID,
+			ClientIp:     event.ClientIp,
+			OccurredAt:   event.OccurredAt.Time.UTC().Format(time.RFC3339),
+		}
+	}
+
+	var totalItems int32
+	if len(result) > 0 {
+		totalItems = int32(result[0].Total)
+	}
+
+	pageNumber := max(req.PageNumber, 1)
+	pageSize := int32(min(len(result), int(limit)))
+
+	pagination := &core.Pagination{
+		PageNumber:  pageNumber,
+		PageSize:    pageSize,
+		TotalItems:  totalItems,
+		HasNextPage: int32(pageNumber*limit) < totalItems,
+	}
+
+	return &auditpb.GetAuditEventsResponse{
+		Events:     auditEvents,
+		Pagination: pagination,
+	}, nil
+}
+
+// Register implements service.Service.
+func (s *AuditService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	auditpb.RegisterAuditServiceHandlerServer(ctx, mux, s)
+}
+
+func textFilter(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func timeFilter(name string, value string) (pgtype.Timestamptz, error) {
+	if value == "" {
+		return pgtype.Timestamptz{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return pgtype.Timestamptz{}, status.Errorf(codes.InvalidArgument, "invalid %s time: %v", name, err)
+	}
+
+	return pgtype.Timestamptz{
+		Time:  t,
+		Valid: true,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/audit"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type AuditEventRecordedEvent struct {
+	Id      ulid.ULID
+	Payload *audit.AuditEventRecordedEventData
+}
+
+var _ core.EventSpec = (*AuditEventRecordedEvent)(nil)
+
+func NewAuditEventRecordedEvent(
+	payload *audit.AuditEventRecordedEventData,
+) *AuditEventRecordedEvent {
+	return &AuditEventRecordedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewAuditEventRecordedEventFromMessage(
+	msg jetstream.Msg,
+) (*AuditEventRecordedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &audit.AuditEventRecordedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &AuditEventRecordedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *AuditEventRecordedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *AuditEventRecordedEvent) Type() string {
+	return AUDIT_EVENT_RECORDED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *AuditEventRecordedEvent) Data() proto.Message {
+	return ev.Payload
+}
@@ -0,0 +1,6 @@
+package events
+
+const (
+	AUDIT_CHANNEL              string = "audit"
+	AUDIT_EVENT_RECORDED_EVENT string = "audit.event.recorded"
+)
@@ -0,0 +1,108 @@
+package audit
+
+import (
+	auditpb "backend/gen/audit"
+	auditdb "backend/internal/audit/db"
+	"backend/internal/audit/events"
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// OutboxRecorder stores audit entries in the audit outbox, they reach the
+// audit.events table once published to the audit stream.
+type OutboxRecorder struct {
+	pool *pgxpool.Pool
+	db   *auditdb.Queries
+}
+
+var _ audit.Recorder = (*OutboxRecorder)(nil)
+
+func NewOutboxRecorder(
+	pool *pgxpool.Pool,
+	db *auditdb.Queries,
+) audit.Recorder {
+	return &OutboxRecorder{
+		pool: pool,
+		db:   db,
+	}
+}
+
+// Record implements audit.Recorder. Failures are logged, they never fail the
+// audited request.
+func (r *OutboxRecorder) Record(ctx context.Context, entries ...audit.Entry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return r.RecordTx(ctx, tx, entries...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to record audit events", "action", entries[0].Action, "error", err)
+	}
+}
+
+// RecordTx implements audit.Recorder. The entries are written in tx, the
+// caller commits them along with the audited change.
+func (r *OutboxRecorder) RecordTx(ctx context.Context, tx pgx.Tx, entries ...audit.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	data := &auditpb.AuditEventRecordedEventData{
+		TenantId:   auth.Tenant(ctx),
+		TraceId:    traceID(ctx),
+		ClientIp:   audit.ClientIP(ctx),
+		OccurredAt: time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if identity, ok := auth.IdentityFromContext(ctx); ok {
+		data.Actor = identity.Subject
+		data.AuthMethod = identity.Method
+	}
+
+	qtx := r.db.WithTx(tx)
+
+	for _, entry := range entries {
+		payload := proto.Clone(data).(*auditpb.AuditEventRecordedEventData)
+		payload.Action = entry.Action
+		payload.ResourceType = entry.ResourceType
+		payload.ResourceId = entry.ResourceID
+
+		ev := events.NewAuditEventRecordedEvent(payload)
+
+		body, err := protojson.Marshal(ev.Data())
+		if err != nil {
+			return err
+		}
+
+		if err := qtx.CreateOutboxEvent(ctx, auditdb.CreateOutboxEventParams{
+			EventID: pgtype.UUID{
+				Bytes: ev.Id,
+				Valid: true,
+			},
+			EventType: ev.Type(),
+			Payload:   body,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func traceID(ctx context.Context) string {
+	if sc := trace.SpanFromContext(ctx).SpanContext(); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package eval
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var errOffline = errors.New("offline: no recorded response for request")
+
+// offlineTransport fails every request so cache misses surface as errors
+// instead of reaching the provider.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errOffline
+}
+
+// dirCache stores agent responses as files named after their cache key. It
+// only implements the parts of jetstream.KeyValue the agents use.
+type dirCache struct {
+	jetstream.KeyValue
+	dir  string
+	read bool
+}
+
+func (c *dirCache) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if !c.read || c.dir == "" {
+		return nil, jetstream.ErrKeyNotFound
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, key+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dirEntry{key: key, value: data}, nil
+}
+
+func (c *dirCache) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	if c.dir == "" {
+		return 0, nil
+	}
+
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return 0, err
+	}
+
+	return 0, os.WriteFile(filepath.Join(c.dir, key+".json"), value, 0o644)
+}
+
+type dirEntry struct {
+	jetstream.KeyValueEntry
+	key   string
+	value []byte
+}
+
+func (e *dirEntry) Key() string {
+	return e.key
+}
+
+func (e *dirEntry) Value() []byte {
+	return e.value
+}
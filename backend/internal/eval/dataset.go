@@ -0,0 +1,48 @@
+package eval
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Sample is a page image with its ground-truth text.
+type Sample struct {
+	Name      string
+	ImagePath string
+	Reference string
+}
+
+// LoadDataset reads every PNG page image in dir paired with a text file of the
+// same base name holding the ground truth, e.g. page-01.png and page-01.txt.
+func LoadDataset(dir string) ([]Sample, error) {
+	images, err := filepath.Glob(filepath.Join(dir, "*.png"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(images)
+
+	samples := make([]Sample, 0, len(images))
+	for _, image := range images {
+		name := strings.TrimSuffix(filepath.Base(image), filepath.Ext(image))
+
+		reference, err := os.ReadFile(filepath.Join(dir, name+".txt"))
+		if err != nil {
+			return nil, fmt.Errorf("missing ground truth for %s: %w", name, err)
+		}
+
+		samples = append(samples, Sample{
+			Name:      name,
+			ImagePath: image,
+			Reference: string(reference),
+		})
+	}
+
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no page images found in %s", dir)
+	}
+
+	return samples, nil
+}
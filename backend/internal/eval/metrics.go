@@ -0,0 +1,81 @@
+package eval
+
+import "strings"
+
+// Score holds the edit distance between a hypothesis and its reference along
+// with the reference length, so rates can be aggregated over many pages.
+type Score struct {
+	CharEdits int `json:"char_edits"`
+	Chars     int `json:"chars"`
+	WordEdits int `json:"word_edits"`
+	Words     int `json:"words"`
+}
+
+// CER returns the character error rate.
+func (s Score) CER() float64 {
+	return rate(s.CharEdits, s.Chars)
+}
+
+// WER returns the word error rate.
+func (s Score) WER() float64 {
+	return rate(s.WordEdits, s.Words)
+}
+
+func (s Score) Add(other Score) Score {
+	return Score{
+		CharEdits: s.CharEdits + other.CharEdits,
+		Chars:     s.Chars + other.Chars,
+		WordEdits: s.WordEdits + other.WordEdits,
+		Words:     s.Words + other.Words,
+	}
+}
+
+// Compare scores a hypothesis against the reference text. Whitespace is
+// normalised so layout differences do not count as errors.
+func Compare(reference string, hypothesis string) Score {
+	refWords := strings.Fields(reference)
+	hypWords := strings.Fields(hypothesis)
+
+	refChars := []rune(strings.Join(refWords, " "))
+	hypChars := []rune(strings.Join(hypWords, " "))
+
+	return Score{
+		CharEdits: distance(refChars, hypChars),
+		Chars:     len(refChars),
+		WordEdits: distance(refWords, hypWords),
+		Words:     len(refWords),
+	}
+}
+
+func rate(edits int, total int) float64 {
+	if total == 0 {
+		if edits == 0 {
+			return 0
+		}
+		return 1
+	}
+	return float64(edits) / float64(total)
+}
+
+// distance returns the Levenshtein distance between two sequences.
+func distance[T comparable](a []T, b []T) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
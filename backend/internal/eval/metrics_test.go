@@ -0,0 +1,112 @@
+package eval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name       string
+		reference  string
+		hypothesis string
+		want       Score
+		wantCER    float64
+		wantWER    float64
+	}{
+		{
+			name:       "identical",
+			reference:  "hello world",
+			hypothesis: "hello world",
+			want:       Score{CharEdits: 0, Chars: 11, WordEdits: 0, Words: 2},
+		},
+		{
+			name:       "whitespace is normalised",
+			reference:  "hello\n  world",
+			hypothesis: " hello world\t",
+			want:       Score{CharEdits: 0, Chars: 11, WordEdits: 0, Words: 2},
+		},
+		{
+			name:       "substitution",
+			reference:  "hello world",
+			hypothesis: "hallo world",
+			want:       Score{CharEdits: 1, Chars: 11, WordEdits: 1, Words: 2},
+			wantCER:    1.0 / 11,
+			wantWER:    0.5,
+		},
+		{
+			name:       "insertion and deletion",
+			reference:  "the quick fox",
+			hypothesis: "the quick brown",
+			want:       Score{CharEdits: 4, Chars: 13, WordEdits: 1, Words: 3},
+			wantCER:    4.0 / 13,
+			wantWER:    1.0 / 3,
+		},
+		{
+			name:       "missing word",
+			reference:  "one two three",
+			hypothesis: "one three",
+			want:       Score{CharEdits: 4, Chars: 13, WordEdits: 1, Words: 3},
+			wantCER:    4.0 / 13,
+			wantWER:    1.0 / 3,
+		},
+		{
+			name:       "runes not bytes",
+			reference:  "straße",
+			hypothesis: "strasse",
+			want:       Score{CharEdits: 2, Chars: 6, WordEdits: 1, Words: 1},
+			wantCER:    2.0 / 6,
+			wantWER:    1,
+		},
+		{
+			name:       "empty hypothesis",
+			reference:  "abc",
+			hypothesis: "",
+			want:       Score{CharEdits: 3, Chars: 3, WordEdits: 1, Words: 1},
+			wantCER:    1,
+			wantWER:    1,
+		},
+		{
+			name:       "empty reference and hypothesis",
+			reference:  "",
+			hypothesis: "",
+			want:       Score{},
+		},
+		{
+			name:       "empty reference",
+			reference:  "",
+			hypothesis: "noise",
+			want:       Score{CharEdits: 5, Chars: 0, WordEdits: 1, Words: 0},
+			wantCER:    1,
+			wantWER:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compare(tt.reference, tt.hypothesis)
+			if got != tt.want {
+				t.Fatalf("Compare() = %+v, want %+v", got, tt.want)
+			}
+			if cer := got.CER(); math.Abs(cer-tt.wantCER) > 1e-9 {
+				t.Errorf("CER() = %v, want %v", cer, tt.wantCER)
+			}
+			if wer := got.WER(); math.Abs(wer-tt.wantWER) > 1e-9 {
+				t.Errorf("WER() = %v, want %v", wer, tt.wantWER)
+			}
+		})
+	}
+}
+
+func TestScoreAdd(t *testing.T) {
+	// Rates aggregate over the totals, not as the mean of page rates
+	total := Compare("abcd", "abcx").Add(Compare("ab", "ab"))
+
+	want := Score{CharEdits: 1, Chars: 6, WordEdits: 1, Words: 2}
+	if total != want {
+		t.Fatalf("Add() = %+v, want %+v", total, want)
+	}
+	if cer := total.CER(); math.Abs(cer-1.0/6) > 1e-9 {
+		t.Errorf("CER() = %v, want %v", cer, 1.0/6)
+	}
+}
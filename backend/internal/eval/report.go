@@ -0,0 +1,93 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type PageResult struct {
+	Name             string  `json:"name"`
+	CER              float64 `json:"cer"`
+	WER              float64 `json:"wer"`
+	Cached           bool    `json:"cached"`
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CompletionTokens int64   `json:"completion_tokens"`
+	Cost             float64 `json:"cost"`
+	Error            string  `json:"error,omitempty"`
+}
+
+// ConfigReport aggregates the results of one configuration. CER and WER are
+// computed over the whole dataset rather than averaged per page.
+type ConfigReport struct {
+	Name             string       `json:"name"`
+	Model            string       `json:"model"`
+	Pages            int          `json:"pages"`
+	Failed           int          `json:"failed"`
+	CER              float64      `json:"cer"`
+	WER              float64      `json:"wer"`
+	Score            Score        `json:"score"`
+	PromptTokens     int64        `json:"prompt_tokens"`
+	CompletionTokens int64        `json:"completion_tokens"`
+	Cost             float64      `json:"cost"`
+	Results          []PageResult `json:"results"`
+}
+
+type Report struct {
+	Dataset   string          `json:"dataset"`
+	Mode      string          `json:"mode"`
+	CreatedAt time.Time       `json:"created_at"`
+	Configs   []*ConfigReport `json:"configs"`
+}
+
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# OCR evaluation\n\n")
+	fmt.Fprintf(&b, "- Dataset: `%s`\n", r.Dataset)
+	fmt.Fprintf(&b, "- Mode: %s\n", r.Mode)
+	fmt.Fprintf(&b, "- Date: %s\n\n", r.CreatedAt.Format(time.RFC3339))
+
+	fmt.Fprintf(&b, "## Summary\n\n")
+	fmt.Fprintf(&b, "| Config | Model | Pages | Failed | CER | WER | Prompt tokens | Completion tokens | Cost (USD) |\n")
+	fmt.Fprintf(&b, "|---|---|---:|---:|---:|---:|---:|---:|---:|\n")
+	for _, c := range r.Configs {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %.4f | %.4f | %d | %d | %.4f |\n",
+			c.Name, c.Model, c.Pages, c.Failed, c.CER, c.WER,
+			c.PromptTokens, c.CompletionTokens, c.Cost,
+		)
+	}
+
+	if len(r.Configs) > 0 {
+		fmt.Fprintf(&b, "\n## Pages (CER / WER)\n\n")
+		fmt.Fprintf(&b, "| Page |")
+		for _, c := range r.Configs {
+			fmt.Fprintf(&b, " %s |", c.Name)
+		}
+		fmt.Fprintf(&b, "\n|---|%s\n", strings.Repeat("---:|", len(r.Configs)))
+
+		for i, page := range r.Configs[0].Results {
+			fmt.Fprintf(&b, "| %s |", page.Name)
+			for _, c := range r.Configs {
+				result := c.Results[i]
+				if result.Error != "" {
+					fmt.Fprintf(&b, " error |")
+					continue
+				}
+				fmt.Fprintf(&b, " %.4f / %.4f |", result.CER, result.WER)
+			}
+			fmt.Fprintf(&b, "\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,183 @@
+package eval
+
+import (
+	"backend/internal/infrastructure/llm"
+	ocrllm "backend/internal/ocr-llm"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+)
+
+const (
+	// ModeLive calls the provider, optionally recording every response.
+	ModeLive = "live"
+	// ModeReplay serves responses from the LLM_CACHE KV bucket.
+	ModeReplay = "replay"
+	// ModeRecorded serves responses recorded by an earlier live run.
+	ModeRecorded = "recorded"
+)
+
+// Config is a named agents configuration to evaluate.
+type Config struct {
+	Name string
+	Llm  *llm.LlmConfig
+}
+
+type Runner struct {
+	mode    string
+	api     *openai.Client
+	kv      jetstream.KeyValue
+	prompts jetstream.KeyValue
+}
+
+// NewLiveRunner returns a runner that calls the provider. Responses are
+// written to recordings when it is not empty.
+func NewLiveRunner(api *openai.Client, recordings string) *Runner {
+	return &Runner{
+		mode: ModeLive,
+		api:  api,
+		kv:   &dirCache{dir: recordings},
+	}
+}
+
+// NewReplayRunner returns a runner that only reads responses from the LLM
+// cache bucket and never reaches the network. Prompts are identified by the
+// matching versions of the prompts bucket, as the services cache them.
+func NewReplayRunner(kv jetstream.KeyValue, prompts jetstream.KeyValue) *Runner {
+	return &Runner{
+		mode:    ModeReplay,
+		api:     offlineClient(),
+		kv:      kv,
+		prompts: prompts,
+	}
+}
+
+// NewRecordedRunner returns a runner that only reads responses recorded in
+// the given directory and never reaches the network.
+func NewRecordedRunner(recordings string) *Runner {
+	return &Runner{
+		mode: ModeRecorded,
+		api:  offlineClient(),
+		kv:   &dirCache{dir: recordings, read: true},
+	}
+}
+
+func (r *Runner) Mode() string {
+	return r.mode
+}
+
+// Run evaluates the OCR agent built from cfg on every sample.
+func (r *Runner) Run(
+	ctx context.Context,
+	cfg Config,
+	samples []Sample,
+) (*ConfigReport, error) {
+	usage, err := llm.NewUsageMeter(cfg.Llm)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := llm.NewCache(r.kv)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := &llm.PromptRegistry{}
+	if r.prompts != nil {
+		versions, err := llm.MatchPromptVersions(ctx, r.prompts, cfg.Llm)
+		if err != nil {
+			return nil, err
+		}
+		registry = llm.NewStaticPromptRegistry(versions...)
+	}
+
+	agent := ocrllm.NewOcrAgent(
+		cfg.Llm,
+		r.api,
+		cache,
+		usage,
+		&llm.Limiter{},
+		registry,
+	)
+
+	report := &ConfigReport{
+		Name:    cfg.Name,
+		Model:   cfg.Llm.Ocr.Chain()[0].Model,
+		Results: make([]PageResult, 0, len(samples)),
+	}
+
+	for _, sample := range samples {
+		page := PageResult{
+			Name: sample.Name,
+		}
+
+		data, err := os.ReadFile(sample.ImagePath)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := agent.Invoke(ctx, ocrllm.NewPageImage(data, "image/png"))
+		if err != nil {
+			page.Error = err.Error()
+			report.add(page, Score{})
+			continue
+		}
+
+		var text string
+		if len(result.Response.Choices) > 0 {
+			text = result.Response.Choices[0].Message.Content
+		}
+
+		score := Compare(sample.Reference, text)
+		page.CER = score.CER()
+		page.WER = score.WER()
+
+		// Cached responses still carry the usage of the original call
+		page.Cached = result.Usage == nil
+		spent := result.Usage
+		if spent == nil {
+			spent = usage.Record(ctx, "ocr", &cfg.Llm.Ocr.Chain()[0], result.Response)
+		}
+		page.PromptTokens = spent.PromptTokens
+		page.CompletionTokens = spent.CompletionTokens
+		page.Cost = spent.Cost
+
+		report.add(page, score)
+	}
+
+	return report, nil
+}
+
+func offlineClient() *openai.Client {
+	client := openai.NewClient(
+		option.WithHTTPClient(&http.Client{Transport: offlineTransport{}}),
+		option.WithAPIKey("offline"),
+		option.WithMaxRetries(0),
+	)
+	return &client
+}
+
+func (r *ConfigReport) add(page PageResult, score Score) {
+	r.Results = append(r.Results, page)
+	r.Pages++
+	if page.Error != "" {
+		r.Failed++
+		return
+	}
+
+	r.Score = r.Score.Add(score)
+	r.CER = r.Score.CER()
+	r.WER = r.Score.WER()
+	r.PromptTokens += page.PromptTokens
+	r.CompletionTokens += page.CompletionTokens
+	r.Cost += page.Cost
+}
+
+func (r *ConfigReport) String() string {
+	return fmt.Sprintf("%s: CER %.4f WER %.4f (%d pages, %d failed)", r.Name, r.CER, r.WER, r.Pages, r.Failed)
+}
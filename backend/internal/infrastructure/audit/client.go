@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+type clientIPKey struct{}
+
+// ClientIP returns the address of the client that sent the request.
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// NewClientMiddleware stores the client address in the request context.
+// trustedProxies is the number of proxies in front of the gateway, each one
+// appends the address it received the request from to X-Forwarded-For.
+func NewClientMiddleware(trustedProxies int) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			ctx := context.WithValue(r.Context(), clientIPKey{}, clientIP(r, trustedProxies))
+			next(w, r.WithContext(ctx), pathParams)
+		}
+	}
+}
+
+// clientIP returns the address the right-most trusted proxy received the
+// request from. Entries left of it are set by the caller and can be forged,
+// the header is ignored when no proxy is trusted.
+func clientIP(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var hops []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			for _, hop := range strings.Split(header, ",") {
+				hops = append(hops, strings.TrimSpace(hop))
+			}
+		}
+		if len(hops) >= trustedProxies {
+			if ip := hops[len(hops)-trustedProxies]; ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
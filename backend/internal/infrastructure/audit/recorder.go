@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Actions recorded for user-initiated requests.
+const (
+	ActionAccessDenied   = "access.denied"
+	ActionFileUploaded   = "file.uploaded"
+	ActionFileListed     = "file.listed"
+	ActionFileViewed     = "file.viewed"
+	ActionFileDownloaded = "file.downloaded"
+	ActionFileExported   = "file.exported"
+	ActionFileDeleted    = "file.deleted"
+	ActionFileRestored   = "file.restored"
+	ActionFileUpdated    = "file.updated"
+	ActionFolderCreated  = "folder.created"
+	ActionFolderUpdated  = "folder.updated"
+	ActionFolderDeleted  = "folder.deleted"
+	ActionPageViewed     = "page.viewed"
+)
+
+// Resource types of audit entries.
+const (
+	ResourceFile    = "file"
+	ResourceFolder  = "folder"
+	ResourcePage    = "page"
+	ResourceRequest = "request"
+)
+
+// Entry is an action taken by the caller of a request on a resource.
+type Entry struct {
+	Action       string
+	ResourceType string
+	ResourceID   string
+}
+
+// Recorder stores audit entries. The actor, tenant, trace and client are
+// read from the request context.
+//
+// Record is meant for reads, it never fails the request. Mutations use
+// RecordTx so the entries commit or roll back with the audited change.
+type Recorder interface {
+	Record(ctx context.Context, entries ...Entry)
+	RecordTx(ctx context.Context, tx pgx.Tx, entries ...Entry) error
+}
+
+// NopRecorder discards every entry, for processes without an audit store.
+type NopRecorder struct{}
+
+var _ Recorder = (*NopRecorder)(nil)
+
+func NewNopRecorder() Recorder {
+	return &NopRecorder{}
+}
+
+// Record implements Recorder.
+func (r *NopRecorder) Record(ctx context.Context, entries ...Entry) {}
+
+// RecordTx implements Recorder.
+func (r *NopRecorder) RecordTx(ctx context.Context, tx pgx.Tx, entries ...Entry) error {
+	return nil
+}
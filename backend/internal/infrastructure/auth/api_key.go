@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"backend/internal/infrastructure/config"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+)
+
+const ApiKeyHeader = "X-Api-Key"
+
+type apiKey struct {
+	name   string
+	hash   []byte
+	scopes []Scope
+	tenant string
+}
+
+// ApiKeyAuthenticator accepts keys sent in the X-Api-Key header whose
+// SHA-256 matches a configured hash.
+type ApiKeyAuthenticator struct {
+	keys []apiKey
+}
+
+var _ Authenticator = (*ApiKeyAuthenticator)(nil)
+
+func NewApiKeyAuthenticator(cfg []config.ApiKeyConfig) (*ApiKeyAuthenticator, error) {
+	keys := make([]apiKey, len(cfg))
+	for i, key := range cfg {
+		hash, err := hex.DecodeString(key.Hash)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key %q: hash must be a hex SHA-256", key.Name)
+		}
+		if key.Tenant != "" && !validTenant.MatchString(key.Tenant) {
+			return nil, fmt.Errorf("api key %q: invalid tenant %q", key.Name, key.Tenant)
+		}
+		keys[i] = apiKey{
+			name:   key.Name,
+			hash:   hash,
+			scopes: scopes(key.Scopes),
+			tenant: key.Tenant,
+		}
+	}
+
+	return &ApiKeyAuthenticator{keys: keys}, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *ApiKeyAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	value := r.Header.Get(ApiKeyHeader)
+	if value == "" {
+		return nil, ErrNoCredentials
+	}
+
+	hash := sha256.Sum256([]byte(value))
+
+	// Compare against every key so the response time does not leak which
+	// one matched
+	var match *apiKey
+	for i := range a.keys {
+		if subtle.ConstantTimeCompare(hash[:], a.keys[i].hash) == 1 {
+			match = &a.keys[i]
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%w: unknown api key", ErrInvalidCredentials)
+	}
+
+	return &Identity{
+		Subject: match.name,
+		Method:  MethodApiKey,
+		Scopes:  match.scopes,
+		Tenant:  match.tenant,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"backend/internal/infrastructure/config"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func hashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestNewApiKeyAuthenticator(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     config.ApiKeyConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			key:  config.ApiKeyConfig{Name: "ci", Hash: hashKey("secret"), Tenant: "acme"},
+		},
+		{
+			name:    "clear text key",
+			key:     config.ApiKeyConfig{Name: "ci", Hash: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "short hash",
+			key:     config.ApiKeyConfig{Name: "ci", Hash: hashKey("secret")[:32]},
+			wantErr: true,
+		},
+		{
+			name:    "invalid tenant",
+			key:     config.ApiKeyConfig{Name: "ci", Hash: hashKey("secret"), Tenant: "a/b"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewApiKeyAuthenticator([]config.ApiKeyConfig{tt.key})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewApiKeyAuthenticator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApiKeyAuthenticate(t *testing.T) {
+	a, err := NewApiKeyAuthenticator([]config.ApiKeyConfig{
+		{Name: "reader", Hash: hashKey("reader-key"), Scopes: []string{"files:read"}},
+		{Name: "acme", Hash: hashKey("acme-key"), Scopes: []string{"files:read", "files:read_unredacted"}, Tenant: "acme"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		wantErr     error
+		wantSubject string
+		wantScopes  []Scope
+		wantTenant  string
+	}{
+		{
+			name:    "missing key",
+			key:     "",
+			wantErr: ErrNoCredentials,
+		},
+		{
+			name:    "key mismatch",
+			key:     "reader-key2",
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name:    "hash sent as key",
+			key:     hashKey("reader-key"),
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name:        "default tenant",
+			key:         "reader-key",
+			wantSubject: "reader",
+			wantScopes:  []Scope{ScopeRead},
+		},
+		{
+			name:        "tenant key",
+			key:         "acme-key",
+			wantSubject: "acme",
+			wantScopes:  []Scope{ScopeRead, ScopeReadUnredacted},
+			wantTenant:  "acme",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			if tt.key != "" {
+				r.Header.Set(ApiKeyHeader, tt.key)
+			}
+
+			identity, err := a.Authenticate(r)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if identity.Subject != tt.wantSubject || identity.Method != MethodApiKey {
+				t.Errorf("Authenticate() identity = %+v", identity)
+			}
+			if !slices.Equal(identity.Scopes, tt.wantScopes) {
+				t.Errorf("Authenticate() scopes = %v, want %v", identity.Scopes, tt.wantScopes)
+			}
+			if identity.Tenant != tt.wantTenant {
+				t.Errorf("Authenticate() tenant = %q, want %q", identity.Tenant, tt.wantTenant)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"backend/internal/infrastructure/config"
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request does
+	// not carry its kind of credentials, so the next one can be tried.
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Authenticator resolves the caller of a request from its credentials.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// Authenticators tries each configured authenticator in turn.
+type Authenticators struct {
+	enabled        bool
+	authenticators []Authenticator
+}
+
+var _ Authenticator = (*Authenticators)(nil)
+
+func NewAuthenticators(cfg *config.AppConfig) (*Authenticators, error) {
+	a := &Authenticators{
+		enabled: cfg.Auth.Enabled,
+	}
+	if !a.enabled {
+		return a, nil
+	}
+
+	if len(cfg.Auth.ApiKeys) > 0 {
+		apiKeys, err := NewApiKeyAuthenticator(cfg.Auth.ApiKeys)
+		if err != nil {
+			return nil, err
+		}
+		a.authenticators = append(a.authenticators, apiKeys)
+	}
+
+	if cfg.Auth.Jwt.JwksFile != "" {
+		jwt, err := NewJwtAuthenticator(cfg.Auth.Jwt)
+		if err != nil {
+			return nil, err
+		}
+		a.authenticators = append(a.authenticators, jwt)
+	}
+
+	return a, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *Authenticators) Authenticate(r *http.Request) (*Identity, error) {
+	for _, authenticator := range a.authenticators {
+		identity, err := authenticator.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return identity, err
+	}
+
+	return nil, ErrNoCredentials
+}
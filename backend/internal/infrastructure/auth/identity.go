@@ -0,0 +1,32 @@
+package auth
+
+import "context"
+
+const (
+	MethodApiKey = "api_key"
+	MethodJwt    = "jwt"
+)
+
+// Identity is the authenticated caller of an RPC.
+type Identity struct {
+	// Subject is the API key name or the token subject
+	Subject string
+	Method  string
+	Scopes  []Scope
+	// Tenant owns every file the caller uploads or reads
+	Tenant string
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a context carrying the caller identity.
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the caller identity, if the request was
+// authenticated.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
@@ -0,0 +1,295 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testEcKey, _  = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testRsaKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+)
+
+func testJwtAuthenticator() *JwtAuthenticator {
+	return &JwtAuthenticator{
+		keys: map[string]crypto.PublicKey{
+			"ec":  &testEcKey.PublicKey,
+			"rsa": &testRsaKey.PublicKey,
+		},
+		issuer:      "https://issuer.test",
+		audience:    "ocr-api",
+		tenantClaim: "tenant",
+	}
+}
+
+// signToken builds a compact JWT signed with the test key matching kid.
+func signToken(t *testing.T, alg, kid string, claims map[string]any) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch kid {
+	case "ec":
+		r, s, err := ecdsa.Sign(rand.Reader, testEcKey, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	default:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, testRsaKey, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims(now time.Time) map[string]any {
+	return map[string]any{
+		"iss":    "https://issuer.test",
+		"sub":    "user-1",
+		"aud":    "ocr-api",
+		"exp":    now.Add(time.Hour).Unix(),
+		"scope":  "files:read",
+		"tenant": "acme",
+	}
+}
+
+func TestJwtVerify(t *testing.T) {
+	now := time.Now()
+	a := testJwtAuthenticator()
+
+	with := func(key string, value any) map[string]any {
+		claims := validClaims(now)
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   func(t *testing.T) string
+		wantErr bool
+	}{
+		{
+			name:  "valid ES256",
+			token: func(t *testing.T) string { return signToken(t, "ES256", "ec", validClaims(now)) },
+		},
+		{
+			name:  "valid RS256",
+			token: func(t *testing.T) string { return signToken(t, "RS256", "rsa", validClaims(now)) },
+		},
+		{
+			name: "audience list",
+			token: func(t *testing.T) string {
+				return signToken(t, "ES256", "ec", with("aud", []string{"other", "ocr-api"}))
+			},
+		},
+		{
+			name: "expired within clock skew",
+			token: func(t *testing.T) string {
+				return signToken(t, "ES256", "ec", with("exp", now.Add(-30*time.Second).Unix()))
+			},
+		},
+		{
+			name:    "expired",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "ec", with("exp", now.Add(-time.Hour).Unix())) },
+			wantErr: true,
+		},
+		{
+			name:    "missing exp",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "ec", with("exp", nil)) },
+			wantErr: true,
+		},
+		{
+			name:    "not valid yet",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "ec", with("nbf", now.Add(time.Hour).Unix())) },
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "ec", with("aud", "other")) },
+			wantErr: true,
+		},
+		{
+			name:    "missing audience",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "ec", with("aud", nil)) },
+			wantErr: true,
+		},
+		{
+			name:    "wrong issuer",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "ec", with("iss", "https://evil.test")) },
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			token:   func(t *testing.T) string { return signToken(t, "ES256", "missing", validClaims(now)) },
+			wantErr: true,
+		},
+		{
+			name: "algorithm does not match the key",
+			token: func(t *testing.T) string {
+				// An RSA signature presented as ES256 for the RSA key
+				return signToken(t, "ES256", "rsa", validClaims(now))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported algorithm",
+			token: func(t *testing.T) string {
+				return signToken(t, "HS256", "rsa", validClaims(now))
+			},
+			wantErr: true,
+		},
+		{
+			name: "none algorithm",
+			token: func(t *testing.T) string {
+				token := signToken(t, "none", "rsa", validClaims(now))
+				return token[:strings.LastIndex(token, ".")+1]
+			},
+			wantErr: true,
+		},
+		{
+			name: "tampered claims",
+			token: func(t *testing.T) string {
+				token := signToken(t, "ES256", "ec", validClaims(now))
+				claims, _ := json.Marshal(with("sub", "admin"))
+				first, last := strings.Index(token, "."), strings.LastIndex(token, ".")
+				return token[:first+1] + base64.RawURLEncoding.EncodeToString(claims) + token[last:]
+			},
+			wantErr: true,
+		},
+		{
+			name:    "malformed",
+			token:   func(t *testing.T) string { return "not-a-token" },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.verify(tt.token(t), now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJwtAuthenticate(t *testing.T) {
+	now := time.Now()
+	a := testJwtAuthenticator()
+
+	tests := []struct {
+		name       string
+		header     string
+		wantErr    error
+		wantScopes []Scope
+		wantTenant string
+	}{
+		{
+			name:    "no bearer token",
+			header:  "",
+			wantErr: ErrNoCredentials,
+		},
+		{
+			name:    "basic credentials",
+			header:  "Basic dXNlcjpwYXNz",
+			wantErr: ErrNoCredentials,
+		},
+		{
+			name:       "scope claim",
+			header:     "Bearer " + signToken(t, "ES256", "ec", validClaims(now)),
+			wantScopes: []Scope{ScopeRead},
+			wantTenant: "acme",
+		},
+		{
+			name: "scp claim",
+			header: func() string {
+				claims := validClaims(now)
+				delete(claims, "scope")
+				claims["scp"] = []string{"files:read", "files:read_unredacted"}
+				return "Bearer " + signToken(t, "ES256", "ec", claims)
+			}(),
+			wantScopes: []Scope{ScopeRead, ScopeReadUnredacted},
+			wantTenant: "acme",
+		},
+		{
+			name: "invalid tenant",
+			header: func() string {
+				claims := validClaims(now)
+				claims["tenant"] = "../other"
+				return "Bearer " + signToken(t, "ES256", "ec", claims)
+			}(),
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "expired",
+			header: func() string {
+				claims := validClaims(now)
+				claims["exp"] = now.Add(-time.Hour).Unix()
+				return "Bearer " + signToken(t, "ES256", "ec", claims)
+			}(),
+			wantErr: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+
+			identity, err := a.Authenticate(r)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if identity.Subject != "user-1" || identity.Method != MethodJwt {
+				t.Errorf("Authenticate() identity = %+v", identity)
+			}
+			if !slices.Equal(identity.Scopes, tt.wantScopes) {
+				t.Errorf("Authenticate() scopes = %v, want %v", identity.Scopes, tt.wantScopes)
+			}
+			if identity.Tenant != tt.wantTenant {
+				t.Errorf("Authenticate() tenant = %q, want %q", identity.Tenant, tt.wantTenant)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"backend/internal/infrastructure/audit"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Middleware authenticates gateway requests and enforces the scopes
+// annotated on the RPC they are routed to.
+type Middleware struct {
+	authenticators *Authenticators
+	audit          audit.Recorder
+	rules          map[string]rule
+}
+
+func NewMiddleware(authenticators *Authenticators, recorder audit.Recorder) *Middleware {
+	return &Middleware{
+		authenticators: authenticators,
+		audit:          recorder,
+		rules:          loadRules(),
+	}
+}
+
+// Require enforces scopes on a route that is not bound to an RPC, such as a
+// path registered with runtime.ServeMux.HandlePath. It must be called before
+// the server starts.
+func (m *Middleware) Require(method, path string, scopes ...Scope) {
+	m.rules[method+" "+pathVariable.ReplaceAllString(path, "{$1=*}")] = rule{
+		method: method + " " + path,
+		scopes: scopes,
+	}
+}
+
+// Handler implements runtime.Middleware.
+func (m *Middleware) Handler(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if !m.authenticators.enabled {
+			next(w, r, pathParams)
+			return
+		}
+
+		ctx := r.Context()
+
+		// Routes missing from the index still require an authenticated caller
+		var rule rule
+		if pattern, ok := runtime.HTTPPattern(ctx); ok {
+			rule = m.rules[r.Method+" "+pattern.String()]
+		}
+		if rule.public {
+			next(w, r, pathParams)
+			return
+		}
+
+		denied := audit.Entry{
+			Action:       audit.ActionAccessDenied,
+			ResourceType: audit.ResourceRequest,
+			ResourceID:   r.Method + " " + r.URL.Path,
+		}
+
+		identity, err := m.authenticators.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			m.audit.Record(ctx, denied)
+			writeError(w, codes.Unauthenticated, "missing credentials")
+			return
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "Rejected credentials", "rpc", rule.method, "error", err)
+			m.audit.Record(ctx, denied)
+			writeError(w, codes.Unauthenticated, "invalid credentials")
+			return
+		}
+
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(
+			attribute.String("enduser.id", identity.Subject),
+			attribute.String("auth.method", identity.Method),
+		)
+
+		for _, scope := range rule.scopes {
+			if !slices.Contains(identity.Scopes, scope) {
+				m.audit.Record(WithIdentity(ctx, identity), denied)
+				writeError(w, codes.PermissionDenied, fmt.Sprintf("missing scope %s", scope))
+				return
+			}
+		}
+
+		next(w, r.WithContext(WithIdentity(ctx, identity)), pathParams)
+	}
+}
+
+// writeError writes the status in the gateway's JSON error format.
+func writeError(w http.ResponseWriter, code codes.Code, message string) {
+	body, _ := protojson.Marshal(status.New(code, message).Proto())
+
+	if code == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(code))
+	_, _ = w.Write(body)
+}
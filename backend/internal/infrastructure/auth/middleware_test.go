@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/config"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
+)
+
+// recorder keeps the audit entries recorded during a request.
+type recorder struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+}
+
+func (r *recorder) Record(ctx context.Context, entries ...audit.Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entries...)
+}
+
+func (r *recorder) RecordTx(ctx context.Context, tx pgx.Tx, entries ...audit.Entry) error {
+	r.Record(ctx, entries...)
+	return nil
+}
+
+func testMux(t *testing.T, enabled bool) (*runtime.ServeMux, *recorder) {
+	t.Helper()
+
+	apiKeys, err := NewApiKeyAuthenticator([]config.ApiKeyConfig{
+		{Name: "reader", Hash: hashKey("reader-key"), Scopes: []string{"files:read"}},
+		{Name: "none", Hash: hashKey("none-key")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	audits := &recorder{}
+	m := &Middleware{
+		authenticators: &Authenticators{
+			enabled:        enabled,
+			authenticators: []Authenticator{apiKeys},
+		},
+		audit: audits,
+		rules: map[string]rule{},
+	}
+	m.Require(http.MethodGet, "/files/{file_key}", ScopeRead)
+	m.Require(http.MethodGet, "/files/{file_key}/raw", ScopeRead, ScopeReadUnredacted)
+	m.rules["GET /health"] = rule{method: "health.Check", public: true}
+
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(m.Handler))
+	for _, path := range []string{"/files/{file_key}", "/files/{file_key}/raw", "/health", "/unknown"} {
+		err := mux.HandlePath(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			if identity, ok := IdentityFromContext(r.Context()); ok {
+				w.Header().Set("X-Subject", identity.Subject)
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return mux, audits
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		disabled    bool
+		path        string
+		key         string
+		wantStatus  int
+		wantSubject string
+		wantAudits  int
+	}{
+		{
+			name:       "auth disabled",
+			disabled:   true,
+			path:       "/files/abc",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "public route",
+			path:       "/health",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing credentials",
+			path:       "/files/abc",
+			wantStatus: http.StatusUnauthorized,
+			wantAudits: 1,
+		},
+		{
+			name:       "api key mismatch",
+			path:       "/files/abc",
+			key:        "wrong-key",
+			wantStatus: http.StatusUnauthorized,
+			wantAudits: 1,
+		},
+		{
+			name:        "granted scope",
+			path:        "/files/abc",
+			key:         "reader-key",
+			wantStatus:  http.StatusOK,
+			wantSubject: "reader",
+		},
+		{
+			name:       "missing scope",
+			path:       "/files/abc",
+			key:        "none-key",
+			wantStatus: http.StatusForbidden,
+			wantAudits: 1,
+		},
+		{
+			name:       "missing one of several scopes",
+			path:       "/files/abc/raw",
+			key:        "reader-key",
+			wantStatus: http.StatusForbidden,
+			wantAudits: 1,
+		},
+		{
+			name:       "unknown route without credentials",
+			path:       "/unknown",
+			wantStatus: http.StatusUnauthorized,
+			wantAudits: 1,
+		},
+		{
+			name:        "unknown route with credentials",
+			path:        "/unknown",
+			key:         "none-key",
+			wantStatus:  http.StatusOK,
+			wantSubject: "none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, audits := testMux(t, !tt.disabled)
+
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.key != "" {
+				r.Header.Set(ApiKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if subject := w.Header().Get("X-Subject"); subject != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", subject, tt.wantSubject)
+			}
+			if len(audits.entries) != tt.wantAudits {
+				t.Errorf("audit entries = %d, want %d", len(audits.entries), tt.wantAudits)
+			}
+			for _, entry := range audits.entries {
+				if entry.Action != audit.ActionAccessDenied {
+					t.Errorf("audit action = %q, want %q", entry.Action, audit.ActionAccessDenied)
+				}
+			}
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Errorf("missing WWW-Authenticate challenge")
+			}
+		})
+	}
+}
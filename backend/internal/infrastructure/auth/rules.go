@@ -0,0 +1,74 @@
+package auth
+
+import (
+	authpb "backend/gen/auth"
+	"net/http"
+	"regexp"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type rule struct {
+	method string
+	scopes []Scope
+	public bool
+}
+
+var pathVariable = regexp.MustCompile(`\{([^}=]+)\}`)
+
+// loadRules indexes the auth rules of every registered RPC by HTTP method
+// and gateway path pattern, as printed by runtime.Pattern.
+func loadRules() map[string]rule {
+	rules := map[string]rule{}
+
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := range services.Len() {
+			methods := services.Get(i).Methods()
+			for j := range methods.Len() {
+				method := methods.Get(j)
+
+				binding, _ := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if binding == nil {
+					continue
+				}
+
+				r := rule{method: string(method.FullName())}
+				if options, _ := proto.GetExtension(method.Options(), authpb.E_Rule).(*authpb.Rule); options != nil {
+					r.scopes = scopes(options.Scopes)
+					r.public = options.Public
+				}
+
+				for _, b := range append([]*annotations.HttpRule{binding}, binding.AdditionalBindings...) {
+					if verb, path := route(b); verb != "" {
+						rules[verb+" "+pathVariable.ReplaceAllString(path, "{$1=*}")] = r
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	return rules
+}
+
+func route(binding *annotations.HttpRule) (string, string) {
+	switch pattern := binding.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.Kind, pattern.Custom.Path
+	}
+	return "", ""
+}
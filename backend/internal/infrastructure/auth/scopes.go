@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+type Scope string
+
+const (
+	// ScopeRead allows reading files, their pages and processing events
+	ScopeRead Scope = "files:read"
+	// ScopeReadUnredacted allows reading OCR text and page images without
+	// PII redaction applied
+	ScopeReadUnredacted Scope = "files:read_unredacted"
+)
+
+// Scopes returns the scopes granted to the caller.
+func Scopes(ctx context.Context) []Scope {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return identity.Scopes
+}
+
+// HasScope reports whether the caller was granted scope.
+func HasScope(ctx context.Context, scope Scope) bool {
+	return slices.Contains(Scopes(ctx), scope)
+}
+
+func scopes(values []string) []Scope {
+	result := make([]Scope, len(values))
+	for i, value := range values {
+		result[i] = Scope(value)
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+	"regexp"
+)
+
+// DefaultTenant owns the files of callers without a tenant, including every
+// caller when auth is disabled, and of events published before tenants were
+// introduced.
+const DefaultTenant = "default"
+
+// Tenant returns the tenant of the caller.
+func Tenant(ctx context.Context) string {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return DefaultTenant
+	}
+	return EventTenant(identity.Tenant)
+}
+
+// EventTenant returns the tenant carried by an event payload.
+func EventTenant(tenantId string) string {
+	if tenantId == "" {
+		return DefaultTenant
+	}
+	return tenantId
+}
+
+// validTenant restricts tenant ids to characters safe in object keys and
+// NATS subjects.
+var validTenant = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
@@ -2,22 +2,33 @@ package config
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type AppConfig struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Storage  StorageConfig  `mapstructure:"storage"`
-	Cors     CorsConfig     `mapstructure:"cors"`
-	Nats     NatsConfig     `mapstructure:"nats"`
-	Telegram TelegramConfig `mapstructure:"telegram"`
-	Postgres PostgresConfig `mapstructure:"postgres"`
-	LLM      LLMConfig      `mapstructure:"llm"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Storage   StorageConfig   `mapstructure:"storage"`
+	Cors      CorsConfig      `mapstructure:"cors"`
+	Nats      NatsConfig      `mapstructure:"nats"`
+	Telegram  TelegramConfig  `mapstructure:"telegram"`
+	Postgres  PostgresConfig  `mapstructure:"postgres"`
+	LLM       LLMConfig       `mapstructure:"llm"`
+	Redaction RedactionConfig `mapstructure:"redaction"`
+	Layout    LayoutConfig    `mapstructure:"layout"`
+	Auth      AuthConfig      `mapstructure:"auth"`
+	Tenants   TenantsConfig   `mapstructure:"tenants"`
+	Trash     TrashConfig     `mapstructure:"trash"`
+	GC        GCConfig        `mapstructure:"gc"`
 }
 
 type ServerConfig struct {
 	Port int `mapstructure:"port"`
+	// TrustedProxies is the number of proxies in front of the gateway, zero
+	// ignores X-Forwarded-For and uses the peer address
+	TrustedProxies int `mapstructure:"trusted_proxies"`
 }
 
 type CorsConfig struct {
@@ -48,8 +59,109 @@ type PostgresConfig struct {
 }
 
 type LLMConfig struct {
-	BaseUrl string `mapstructure:"base_url"`
-	ApiKey  string `mapstructure:"api_key"`
+	BaseUrl           string        `mapstructure:"base_url"`
+	ApiKey            string        `mapstructure:"api_key"`
+	RequestsPerMinute int           `mapstructure:"requests_per_minute"`
+	TokensPerMinute   int           `mapstructure:"tokens_per_minute"`
+	DailyBudget       float64       `mapstructure:"daily_budget"`
+	CacheTTL          time.Duration `mapstructure:"cache_ttl"`
+	Images            ImagesConfig  `mapstructure:"images"`
+}
+
+type ImagesConfig struct {
+	MaxBytes int64 `mapstructure:"max_bytes"`
+	// MaxPixels bounds width times height, checked before decoding as a
+	// small PNG can decode to gigabytes
+	MaxPixels    int64 `mapstructure:"max_pixels"`
+	TargetBytes  int64 `mapstructure:"target_bytes"`
+	MaxDimension int   `mapstructure:"max_dimension"`
+}
+
+type RedactionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Llm also asks the redact agent for entities the detectors cannot match
+	Llm       bool             `mapstructure:"llm"`
+	Detectors []DetectorConfig `mapstructure:"detectors"`
+}
+
+// DetectorConfig is a regular expression matching one kind of PII entity.
+type DetectorConfig struct {
+	Type    string `mapstructure:"type"`
+	Pattern string `mapstructure:"pattern"`
+}
+
+type LayoutConfig struct {
+	// Mutool is the mutool binary reading word boxes from the text layer of
+	// pages that have one, empty disables it
+	Mutool string `mapstructure:"mutool"`
+	// Tesseract is the tesseract binary used for pages without a text
+	// layer, empty disables it
+	Tesseract string `mapstructure:"tesseract"`
+	Languages string `mapstructure:"languages"`
+}
+
+type AuthConfig struct {
+	// Enabled requires credentials on every RPC not marked public, callers
+	// are anonymous and unrestricted otherwise
+	Enabled bool           `mapstructure:"enabled"`
+	ApiKeys []ApiKeyConfig `mapstructure:"api_keys"`
+	Jwt     JwtConfig      `mapstructure:"jwt"`
+}
+
+type ApiKeyConfig struct {
+	Name string `mapstructure:"name"`
+	// Hash is the hex SHA-256 of the key, keys are never stored in clear
+	Hash   string   `mapstructure:"hash"`
+	Scopes []string `mapstructure:"scopes"`
+	Tenant string   `mapstructure:"tenant"`
+}
+
+type JwtConfig struct {
+	// JwksFile is a local JSON Web Key Set, empty disables bearer tokens
+	JwksFile string `mapstructure:"jwks_file"`
+	Issuer   string `mapstructure:"issuer"`
+	Audience string `mapstructure:"audience"`
+	// TenantClaim names the claim holding the caller's tenant
+	TenantClaim string `mapstructure:"tenant_claim"`
+}
+
+type TenantsConfig struct {
+	// Quota applies to every tenant without an override, zero limits are
+	// unlimited
+	Quota QuotaConfig `mapstructure:"quota"`
+	// Overrides are keyed by lower case tenant id
+	Overrides map[string]QuotaConfig `mapstructure:"overrides"`
+}
+
+type QuotaConfig struct {
+	MaxFiles int64 `mapstructure:"max_files"`
+	MaxBytes int64 `mapstructure:"max_bytes"`
+}
+
+// TenantQuota returns the quota of tenant.
+func (c TenantsConfig) TenantQuota(tenant string) QuotaConfig {
+	if quota, ok := c.Overrides[strings.ToLower(tenant)]; ok {
+		return quota
+	}
+	return c.Quota
+}
+
+type TrashConfig struct {
+	// Retention is how long deleted files can be restored before they are
+	// purged
+	Retention     time.Duration `mapstructure:"retention"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+type GCConfig struct {
+	// Enabled runs the garbage collector periodically in the API
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// GracePeriod is how long objects and pages are left alone before they
+	// are considered garbage, so uploads and OCR in flight are not collected
+	GracePeriod time.Duration `mapstructure:"grace_period"`
+	// DryRun only reports the garbage found
+	DryRun bool `mapstructure:"dry_run"`
 }
 
 func LoadAppConfig() (*AppConfig, error) {
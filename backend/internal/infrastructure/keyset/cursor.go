@@ -0,0 +1,45 @@
+package keyset
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncodeCursor returns the opaque cursor of a keyset position.
+func EncodeCursor(position any) string {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor returned by EncodeCursor into position.
+func DecodeCursor(cursor string, position any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(data, position)
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+	return nil
+}
+
+// SortDesc parses a sort order, asc or desc. An empty order is fallback.
+func SortDesc(order string, fallback bool) (bool, error) {
+	switch strings.ToLower(order) {
+	case "":
+		return fallback, nil
+	case "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	default:
+		return false, status.Errorf(codes.InvalidArgument, "invalid sort order %q", order)
+	}
+}
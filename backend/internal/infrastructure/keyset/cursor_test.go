@@ -0,0 +1,109 @@
+package keyset
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type position struct {
+	CreatedAt string `json:"created_at"`
+	ID        string `json:"id"`
+	Desc      bool   `json:"desc"`
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		position position
+	}{
+		{
+			name:     "ascending",
+			position: position{CreatedAt: "2026-10-19T10:00:00Z", ID: "0192a0b6-0000-7000-8000-000000000001"},
+		},
+		{
+			name:     "descending",
+			position: position{CreatedAt: "2026-10-19T10:00:00.123456789Z", ID: "0192a0b6-0000-7000-8000-000000000002", Desc: true},
+		},
+		{
+			name:     "zero value",
+			position: position{},
+		},
+		{
+			name:     "characters outside base64url",
+			position: position{ID: "a+b/c=d?&"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := EncodeCursor(tt.position)
+			if cursor == "" {
+				t.Fatal("EncodeCursor() returned an empty cursor")
+			}
+			if _, err := base64.RawURLEncoding.DecodeString(cursor); err != nil {
+				t.Fatalf("cursor %q is not URL safe: %v", cursor, err)
+			}
+
+			var got position
+			if err := DecodeCursor(cursor, &got); err != nil {
+				t.Fatalf("DecodeCursor() error = %v", err)
+			}
+			if got != tt.position {
+				t.Errorf("DecodeCursor() = %+v, want %+v", got, tt.position)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "%%%"},
+		{name: "padded base64", cursor: base64.URLEncoding.EncodeToString([]byte(`{"id":"x"}`))},
+		{name: "not json", cursor: base64.RawURLEncoding.EncodeToString([]byte("not json"))},
+		{name: "wrong type", cursor: base64.RawURLEncoding.EncodeToString([]byte(`{"desc":"yes"}`))},
+		{name: "json array", cursor: base64.RawURLEncoding.EncodeToString([]byte(`[1,2]`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got position
+			err := DecodeCursor(tt.cursor, &got)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("DecodeCursor() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestSortDesc(t *testing.T) {
+	tests := []struct {
+		order    string
+		fallback bool
+		want     bool
+		wantErr  bool
+	}{
+		{order: "", fallback: true, want: true},
+		{order: "", fallback: false, want: false},
+		{order: "asc", fallback: true, want: false},
+		{order: "DESC", fallback: false, want: true},
+		{order: "random", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.order, func(t *testing.T) {
+			got, err := SortDesc(tt.order, tt.fallback)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SortDesc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SortDesc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,40 +1,324 @@
 package llm
 
 import (
+	"backend/internal/infrastructure/config"
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/openai/openai-go/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
+const DefaultCacheTTL = 7 * 24 * time.Hour
+
+// CacheKey identifies a cached response by the hash of the agent input (the
+// page image for OCR) rather than the serialised request, so that only a new
+// model or prompt version invalidates entries.
 type CacheKey struct {
-	Model    string
-	Messages []openai.ChatCompletionMessageParamUnion
+	Agent string
+	// Chain is the ChainKey of the agent, responses served by any target of
+	// the chain are cached under it
+	Chain         string
+	PromptVersion string
+	// ContentHash is the ContentHash of the original input, taken before any
+	// resizing so keys stay stable when image limits change
+	ContentHash string
+}
+
+// String formats the key as llm.<agent>.<content>.<prompt version>.<chain>
+// so entries can be filtered by content or prompt version.
+func (k CacheKey) String() string {
+	return fmt.Sprintf(
+		"llm.%s.%s.%s.%s",
+		k.Agent,
+		k.ContentHash,
+		k.PromptVersion,
+		shortHash([]byte(k.Chain)),
+	)
 }
 
-func GenerateCacheKey(params CacheKey) string {
-	data, _ := json.Marshal(params)
+// ContentHash returns the hex SHA-256 of an agent input.
+func ContentHash(content []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
+func shortHash(data []byte) string {
 	hash := sha256.Sum256(data)
-	return fmt.Sprintf("llm.%x", hash[:16])
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+type CacheStats struct {
+	Entries uint64
+	Bytes   uint64
+	TTL     time.Duration
+}
+
+type CacheEntry struct {
+	Key       string
+	Size      int
+	CreatedAt time.Time
+}
+
+// Cache stores LLM responses in the LLM_CACHE KV bucket.
+type Cache struct {
+	kv     jetstream.KeyValue
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+	size   metric.Int64Histogram
 }
 
 func NewLlmCache(
+	cfg *config.AppConfig,
 	js jetstream.JetStream,
-) (jetstream.KeyValue, error) {
+) (*Cache, error) {
+	ttl := cfg.LLM.CacheTTL
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
 	ctx := context.Background()
 	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
 		Bucket:  "LLM_CACHE",
 		History: 1,
-		TTL:     24 * 7 * time.Hour,
+		TTL:     ttl,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCache(kv)
+}
+
+// NewCache wraps an existing bucket.
+func NewCache(kv jetstream.KeyValue) (*Cache, error) {
+	meter := otel.Meter("llm")
+
+	hits, err := meter.Int64Counter(
+		"llm.cache.hits",
+		metric.WithDescription("Number of LLM responses served from the cache"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	misses, err := meter.Int64Counter(
+		"llm.cache.misses",
+		metric.WithDescription("Number of LLM requests not found in the cache"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := meter.Int64Histogram(
+		"llm.cache.entry.size",
+		metric.WithDescription("Size of cached LLM responses"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		kv:     kv,
+		hits:   hits,
+		misses: misses,
+		size:   size,
+	}, nil
+}
+
+// Get returns the cached response for the key, if any.
+func (c *Cache) Get(ctx context.Context, key CacheKey) (*openai.ChatCompletion, bool) {
+	attrs := metric.WithAttributes(attribute.String("agent", key.Agent))
+
+	entry, err := c.kv.Get(ctx, key.String())
+	if err == nil {
+		var response openai.ChatCompletion
+		if err := json.Unmarshal(entry.Value(), &response); err == nil {
+			c.hits.Add(ctx, 1, attrs)
+			c.index(ctx, key.String())
+			return &response, true
+		}
+	}
 
+	c.misses.Add(ctx, 1, attrs)
+	return nil, false
+}
+
+// Put stores the response under the key.
+func (c *Cache) Put(ctx context.Context, key CacheKey, response *openai.ChatCompletion) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.kv.Put(ctx, key.String(), data); err != nil {
+		return fmt.Errorf("error caching llm response: %w", err)
+	}
+	c.index(ctx, key.String())
+
+	c.size.Record(ctx, int64(len(data)), metric.WithAttributes(
+		attribute.String("agent", key.Agent),
+	))
+
+	return nil
+}
+
+func (c *Cache) Stats(ctx context.Context) (*CacheStats, error) {
+	status, err := c.kv.Status(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return kv, nil
+	return &CacheStats{
+		Entries: status.Values(),
+		Bytes:   status.Bytes(),
+		TTL:     status.TTL(),
+	}, nil
+}
+
+// ContentEntries returns the entries cached for the agent input with the given
+// ContentHash.
+func (c *Cache) ContentEntries(ctx context.Context, agent string, hash string) ([]CacheEntry, error) {
+	keys, err := c.keys(ctx, contentFilter(agent, hash))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]CacheEntry, 0, len(keys))
+	for _, key := range keys {
+		entry, err := c.kv.Get(ctx, key)
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, CacheEntry{
+			Key:       key,
+			Size:      len(entry.Value()),
+			CreatedAt: entry.Created(),
+		})
+	}
+
+	return entries, nil
+}
+
+// InvalidateContent removes every entry cached for the agent input with the
+// given ContentHash.
+func (c *Cache) InvalidateContent(ctx context.Context, agent string, hash string) (int, error) {
+	return c.purge(ctx, contentFilter(agent, hash))
+}
+
+// InvalidateFile removes every entry read or written for a file under
+// WithFile, whichever agent it belongs to, together with the file's index.
+func (c *Cache) InvalidateFile(ctx context.Context, file string) (int, error) {
+	prefix := fileIndexPrefix(file)
+	indexKeys, err := c.keys(ctx, prefix+">")
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, indexKey := range indexKeys {
+		key := "llm." + strings.TrimPrefix(indexKey, prefix)
+
+		// Entries may already be gone through another file or their TTL
+		_, err := c.kv.Get(ctx, key)
+		switch {
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+		case err != nil:
+			return deleted, err
+		default:
+			if err := c.kv.Purge(ctx, key); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		if err := c.kv.Purge(ctx, indexKey); err != nil {
+			return deleted, err
+		}
+	}
+
+	return deleted, nil
+}
+
+// InvalidatePromptVersion removes every entry produced with a prompt version.
+func (c *Cache) InvalidatePromptVersion(ctx context.Context, version string) (int, error) {
+	return c.purge(ctx, fmt.Sprintf("llm.*.*.%s.*", version))
+}
+
+func (c *Cache) purge(ctx context.Context, filter string) (int, error) {
+	keys, err := c.keys(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		if err := c.kv.Purge(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(keys), nil
+}
+
+func (c *Cache) keys(ctx context.Context, filter string) ([]string, error) {
+	lister, err := c.kv.ListKeysFiltered(ctx, filter)
+	if errors.Is(err, jetstream.ErrNoKeysFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer lister.Stop()
+
+	var keys []string
+	for key := range lister.Keys() {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func contentFilter(agent string, hash string) string {
+	return fmt.Sprintf("llm.%s.%s.>", agent, hash)
+}
+
+type fileKey struct{}
+
+// WithFile returns a context whose cache reads and writes are indexed under
+// the file they are made for. Text agents are keyed by page text, so the
+// index is how a file's entries are found again.
+func WithFile(ctx context.Context, file string) context.Context {
+	return context.WithValue(ctx, fileKey{}, file)
+}
+
+// index records key under the file of ctx, if any. Entries are still served
+// when indexing fails, they just cannot be invalidated by file.
+func (c *Cache) index(ctx context.Context, key string) {
+	file, ok := ctx.Value(fileKey{}).(string)
+	if !ok {
+		return
+	}
+
+	indexKey := fileIndexPrefix(file) + strings.TrimPrefix(key, "llm.")
+	if _, err := c.kv.Put(ctx, indexKey, []byte(key)); err != nil {
+		slog.WarnContext(ctx, "Failed to index llm cache entry", "file", file, "error", err)
+	}
+}
+
+// fileIndexPrefix keeps index entries out of the llm.> keys, which hold the
+// responses.
+func fileIndexPrefix(file string) string {
+	return "file." + file + "."
 }
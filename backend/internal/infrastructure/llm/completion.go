@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openai/openai-go/v3"
+)
+
+// Complete runs the chat completion against each target of the agent's chain
+// in order, failing over on server errors, timeouts and rate limits. It
+// returns the response together with the target that served it.
+func Complete(
+	ctx context.Context,
+	api *openai.Client,
+	cfg *AgentConfig,
+	params openai.ChatCompletionNewParams,
+) (*openai.ChatCompletion, *TargetConfig, error) {
+	var errs []error
+
+	chain := cfg.Chain()
+	for i := range chain {
+		target := &chain[i]
+
+		response, err := complete(ctx, api, target, params)
+		if err == nil {
+			return response, target, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", target.Model, err))
+		if !retryable(ctx, err) {
+			break
+		}
+	}
+
+	return nil, nil, fmt.Errorf("error creating chat completion: %w", errors.Join(errs...))
+}
+
+// ServedBy returns the target of the agent's chain that served a cached
+// response, matched on the model the response reports and falling back to
+// the first target.
+func ServedBy(cfg *AgentConfig, response *openai.ChatCompletion) *TargetConfig {
+	chain := cfg.Chain()
+	for i := range chain {
+		if strings.HasPrefix(response.Model, chain[i].Model) {
+			return &chain[i]
+		}
+	}
+	return &chain[0]
+}
+
+func complete(
+	ctx context.Context,
+	api *openai.Client,
+	target *TargetConfig,
+	params openai.ChatCompletionNewParams,
+) (*openai.ChatCompletion, error) {
+	if target.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, target.Timeout)
+		defer cancel()
+	}
+
+	params.Model = target.Model
+	params.SetExtraFields(map[string]any{
+		"provider": map[string]any{
+			"order":           target.Providers,
+			"allow_fallbacks": false,
+		},
+	})
+
+	return api.Chat.Completions.New(ctx, params)
+}
+
+// retryable tells whether the next target may still serve the request. A
+// deadline only fails over when it is the per-target timeout, a cancelled or
+// expired caller context stops the chain.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var apiErr *openai.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests ||
+			apiErr.StatusCode == http.StatusRequestTimeout ||
+			apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	// Network failures never reached the provider
+	return true
+}
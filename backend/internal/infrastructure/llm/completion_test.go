@@ -0,0 +1,117 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/openai/openai-go/v3"
+)
+
+func TestRetryable(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Unix(0, 0))
+	defer cancelExpired()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{
+			name: "target timeout",
+			ctx:  context.Background(),
+			err:  fmt.Errorf("post: %w", context.DeadlineExceeded),
+			want: true,
+		},
+		{
+			name: "caller deadline",
+			ctx:  expired,
+			err:  fmt.Errorf("post: %w", context.DeadlineExceeded),
+			want: false,
+		},
+		{
+			name: "caller cancelled",
+			ctx:  cancelled,
+			err:  fmt.Errorf("post: %w", context.Canceled),
+			want: false,
+		},
+		{
+			name: "cancelled error with live caller",
+			ctx:  context.Background(),
+			err:  context.Canceled,
+			want: false,
+		},
+		{
+			name: "rate limited",
+			ctx:  context.Background(),
+			err:  &openai.Error{StatusCode: http.StatusTooManyRequests},
+			want: true,
+		},
+		{
+			name: "server error",
+			ctx:  context.Background(),
+			err:  &openai.Error{StatusCode: http.StatusBadGateway},
+			want: true,
+		},
+		{
+			name: "bad request",
+			ctx:  context.Background(),
+			err:  &openai.Error{StatusCode: http.StatusBadRequest},
+			want: false,
+		},
+		{
+			name: "network failure",
+			ctx:  context.Background(),
+			err:  errors.New("connection refused"),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.ctx, tt.err); got != tt.want {
+				t.Errorf("retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChainKey(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  AgentConfig
+		want string
+	}{
+		{
+			name: "single model",
+			cfg:  AgentConfig{Model: "openai/gpt-4o"},
+			want: "openai/gpt-4o",
+		},
+		{
+			name: "fallback chain",
+			cfg: AgentConfig{
+				Model: "ignored",
+				Targets: []TargetConfig{
+					{Model: "openai/gpt-4o"},
+					{Model: "google/gemini-2.5-flash"},
+				},
+			},
+			want: "openai/gpt-4o,google/gemini-2.5-flash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.ChainKey(); got != tt.want {
+				t.Errorf("ChainKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
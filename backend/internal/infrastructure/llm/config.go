@@ -2,19 +2,105 @@ package llm
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type LlmConfig struct {
-	Ocr AgentConfig `json:"ocr"`
+	Ocr       AgentConfig     `json:"ocr"`
+	Extract   AgentConfig     `json:"extract"`
+	Classify  ClassifyConfig  `json:"classify"`
+	Translate TranslateConfig `json:"translate"`
+	Summarize SummarizeConfig `json:"summarize"`
+	Redact    RedactConfig    `json:"redact"`
+	Prices    []ModelPrice    `json:"prices"`
 }
 
 type AgentConfig struct {
-	Model     string   `json:"model"`
-	Providers []string `json:"providers"`
-	System    string   `json:"system"`
-	User      string   `json:"user"`
+	Model     string         `json:"model"`
+	Providers []string       `json:"providers"`
+	Targets   []TargetConfig `json:"targets"`
+	System    string         `json:"system"`
+	User      string         `json:"user"`
+}
+
+// TargetConfig is a model and its providers tried in order of the fallback
+// chain. A zero timeout leaves the request bound only by the caller context.
+type TargetConfig struct {
+	Model     string        `json:"model"`
+	Providers []string      `json:"providers"`
+	Timeout   time.Duration `json:"timeout"`
+}
+
+// Chain returns the targets to try in order. Agents configured with a single
+// model and no targets get a chain of one.
+func (c *AgentConfig) Chain() []TargetConfig {
+	if len(c.Targets) > 0 {
+		return c.Targets
+	}
+	return []TargetConfig{{
+		Model:     c.Model,
+		Providers: c.Providers,
+	}}
+}
+
+// ChainKey identifies the chain by its models in order. A single model chain
+// is keyed by that model.
+func (c *AgentConfig) ChainKey() string {
+	chain := c.Chain()
+	models := make([]string, len(chain))
+	for i := range chain {
+		models[i] = chain[i].Model
+	}
+	return strings.Join(models, ",")
+}
+
+type ClassifyConfig struct {
+	AgentConfig `mapstructure:",squash"`
+	Pages       int      `json:"pages"`
+	Types       []string `json:"types"`
+}
+
+// TranslateConfig lists the languages pages are translated into. Translation
+// is disabled when no languages are configured.
+type TranslateConfig struct {
+	AgentConfig `mapstructure:",squash"`
+	Languages   []string `json:"languages"`
+}
+
+// SummarizeConfig controls which documents are summarised and how many pages
+// are summarised per call before the partial summaries are combined.
+type SummarizeConfig struct {
+	AgentConfig `mapstructure:",squash"`
+	MinPages    int `json:"min_pages"`
+	ChunkPages  int `json:"chunk_pages"`
+}
+
+// RedactConfig lists the kinds of personal data the LLM detector looks for.
+type RedactConfig struct {
+	AgentConfig `mapstructure:",squash"`
+	Types       []string `json:"types"`
+}
+
+// ModelPrice is the price of a model in USD per million tokens.
+type ModelPrice struct {
+	Model      string  `json:"model"`
+	Prompt     float64 `json:"prompt"`
+	Completion float64 `json:"completion"`
+}
+
+// Agents returns the configuration of every agent keyed by its name.
+func (c *LlmConfig) Agents() map[string]*AgentConfig {
+	return map[string]*AgentConfig{
+		"ocr":       &c.Ocr,
+		"extract":   &c.Extract,
+		"classify":  &c.Classify.AgentConfig,
+		"translate": &c.Translate.AgentConfig,
+		"summarize": &c.Summarize.AgentConfig,
+		"redact":    &c.Redact.AgentConfig,
+	}
 }
 
 func LoadLlmConfig() (*LlmConfig, error) {
@@ -32,3 +118,21 @@ func LoadLlmConfig() (*LlmConfig, error) {
 
 	return &config, nil
 }
+
+// ReadLlmConfig reads an agents config from the given file without touching
+// the global viper instance.
+func ReadLlmConfig(path string) (*LlmConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading prompts config: %w", err)
+	}
+
+	var config LlmConfig
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("error unmarshaling prompts config: %w", err)
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,219 @@
+package llm
+
+import (
+	"backend/internal/infrastructure/config"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var ErrBudgetExhausted = errors.New("daily llm budget exhausted")
+
+// Limiter enforces request and token rate limits and a daily spend cap for
+// LLM calls. Counters live in a JetStream KV bucket so the limits are shared
+// by all replicas. The zero Limiter enforces no limits.
+type Limiter struct {
+	kv                jetstream.KeyValue
+	requestsPerMinute int
+	tokensPerMinute   int
+	dailyBudget       float64
+}
+
+func NewLimiter(
+	cfg *config.AppConfig,
+	js jetstream.JetStream,
+) (*Limiter, error) {
+	ctx := context.Background()
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  "LLM_LIMITS",
+		History: 1,
+		TTL:     48 * time.Hour,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limiter{
+		kv:                kv,
+		requestsPerMinute: cfg.LLM.RequestsPerMinute,
+		tokensPerMinute:   cfg.LLM.TokensPerMinute,
+		dailyBudget:       cfg.LLM.DailyBudget,
+	}, nil
+}
+
+// Wait blocks until a request fits into the rate limits. It returns
+// ErrBudgetExhausted when the daily spend cap has been reached.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		now := time.Now().UTC()
+
+		if l.dailyBudget > 0 {
+			spent, err := l.Spent(ctx)
+			if err != nil {
+				return err
+			}
+			if spent >= l.dailyBudget {
+				return ErrBudgetExhausted
+			}
+		}
+
+		window := now.Truncate(time.Minute)
+		allowed, err := l.allow(ctx, window)
+		if err != nil {
+			return err
+		}
+		if allowed {
+			return nil
+		}
+
+		select {
+		case <-time.After(window.Add(time.Minute).Sub(now)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Record adds the usage of a completed call to the token and spend counters.
+func (l *Limiter) Record(ctx context.Context, usage *Usage) error {
+	if usage == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	if l.tokensPerMinute > 0 {
+		tokens := float64(usage.PromptTokens + usage.CompletionTokens)
+		if _, err := l.add(ctx, tokensKey(now.Truncate(time.Minute)), tokens); err != nil {
+			return err
+		}
+	}
+
+	if l.dailyBudget > 0 && usage.Cost > 0 {
+		if _, err := l.add(ctx, spendKey(now), usage.Cost); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Spent returns the amount spent today.
+func (l *Limiter) Spent(ctx context.Context) (float64, error) {
+	return l.get(ctx, spendKey(time.Now().UTC()))
+}
+
+// Budget returns the configured daily spend cap.
+func (l *Limiter) Budget() float64 {
+	return l.dailyBudget
+}
+
+// ResetAt returns the time the daily budget is replenished.
+func (l *Limiter) ResetAt() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
+
+// MarkExhausted records that today's budget was exhausted. It returns true
+// only for the first caller of the day across all replicas.
+func (l *Limiter) MarkExhausted(ctx context.Context) (bool, error) {
+	key := fmt.Sprintf("exhausted.%s", time.Now().UTC().Format("20060102"))
+	if _, err := l.kv.Create(ctx, key, []byte("1")); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (l *Limiter) allow(ctx context.Context, window time.Time) (bool, error) {
+	if l.tokensPerMinute > 0 {
+		tokens, err := l.get(ctx, tokensKey(window))
+		if err != nil {
+			return false, err
+		}
+		if tokens >= float64(l.tokensPerMinute) {
+			return false, nil
+		}
+	}
+
+	if l.requestsPerMinute > 0 {
+		requests, err := l.add(ctx, requestsKey(window), 1)
+		if err != nil {
+			return false, err
+		}
+		if requests > float64(l.requestsPerMinute) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (l *Limiter) get(ctx context.Context, key string) (float64, error) {
+	entry, err := l.kv.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(string(entry.Value()), 64)
+}
+
+// add atomically increments a counter using optimistic concurrency on the
+// key revision and returns the new value.
+func (l *Limiter) add(ctx context.Context, key string, delta float64) (float64, error) {
+	for {
+		entry, err := l.kv.Get(ctx, key)
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			_, err := l.kv.Create(ctx, key, formatCounter(delta))
+			if errors.Is(err, jetstream.ErrKeyExists) {
+				continue
+			}
+			if err != nil {
+				return 0, err
+			}
+			return delta, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		value, err := strconv.ParseFloat(string(entry.Value()), 64)
+		if err != nil {
+			return 0, err
+		}
+		value += delta
+
+		_, err = l.kv.Update(ctx, key, formatCounter(value), entry.Revision())
+		var apiErr *jetstream.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return value, nil
+	}
+}
+
+func formatCounter(value float64) []byte {
+	return strconv.AppendFloat(nil, value, 'f', -1, 64)
+}
+
+func requestsKey(window time.Time) string {
+	return fmt.Sprintf("rpm.%d", window.Unix())
+}
+
+func tokensKey(window time.Time) string {
+	return fmt.Sprintf("tpm.%d", window.Unix())
+}
+
+func spendKey(day time.Time) string {
+	return fmt.Sprintf("spend.%s", day.Format("20060102"))
+}
@@ -0,0 +1,354 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	ErrUnknownAgent          = errors.New("unknown agent")
+	ErrPromptVersionNotFound = errors.New("prompt version not found")
+)
+
+const (
+	promptVersionPrefix = "version."
+	promptActivePrefix  = "active."
+)
+
+// PromptVersion is an immutable revision of an agent's prompts.
+type PromptVersion struct {
+	Id          string    `json:"id"`
+	Agent       string    `json:"agent"`
+	Description string    `json:"description"`
+	System      string    `json:"system"`
+	User        string    `json:"user"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Tag identifies the prompt in cache keys. Prompts served from the config have
+// no version and are identified by their content instead.
+func (v *PromptVersion) Tag() string {
+	if v.Id != "" {
+		return v.Id
+	}
+	return "cfg-" + shortHash([]byte(v.System+"\x00"+v.User))
+}
+
+// PromptRegistry keeps versioned prompts in a JetStream KV bucket and watches
+// it so that activating a version takes effect on every replica without a
+// restart. Prompts from prompts.yaml seed the first version of each agent.
+// The zero PromptRegistry serves the prompts from the config.
+type PromptRegistry struct {
+	cfg      *LlmConfig
+	kv       jetstream.KeyValue
+	watcher  jetstream.KeyWatcher
+	mu       sync.RWMutex
+	versions map[string]*PromptVersion
+	active   map[string]string
+}
+
+// NewStaticPromptRegistry returns a registry serving the given versions to
+// their agents, without a bucket.
+func NewStaticPromptRegistry(versions ...*PromptVersion) *PromptRegistry {
+	r := &PromptRegistry{
+		versions: make(map[string]*PromptVersion),
+		active:   make(map[string]string),
+	}
+	for _, version := range versions {
+		r.versions[version.Id] = version
+		r.active[version.Agent] = version.Id
+	}
+	return r
+}
+
+// MatchPromptVersions returns, for every agent of cfg, the version stored in
+// a LLM_PROMPTS bucket with the same prompts, preferring the active one. It
+// lets offline runs share cache keys with the services.
+func MatchPromptVersions(
+	ctx context.Context,
+	kv jetstream.KeyValue,
+	cfg *LlmConfig,
+) ([]*PromptVersion, error) {
+	matches := make([]*PromptVersion, 0)
+	for agent, agentCfg := range cfg.Agents() {
+		lister, err := kv.ListKeysFiltered(ctx, promptVersionPrefix+agent+".*")
+		if err != nil {
+			return nil, err
+		}
+
+		var active string
+		if entry, err := kv.Get(ctx, promptActivePrefix+agent); err == nil {
+			active = string(entry.Value())
+		} else if !errors.Is(err, jetstream.ErrKeyNotFound) {
+			lister.Stop()
+			return nil, err
+		}
+
+		var match *PromptVersion
+		for key := range lister.Keys() {
+			entry, err := kv.Get(ctx, key)
+			if err != nil {
+				lister.Stop()
+				return nil, err
+			}
+
+			var version PromptVersion
+			if err := json.Unmarshal(entry.Value(), &version); err != nil {
+				continue
+			}
+			if version.System != agentCfg.System || version.User != agentCfg.User {
+				continue
+			}
+			if match == nil || version.Id == active || (match.Id != active && version.Id > match.Id) {
+				match = &version
+			}
+		}
+		lister.Stop()
+
+		if match != nil {
+			matches = append(matches, match)
+		}
+	}
+
+	return matches, nil
+}
+
+func NewPromptRegistry(
+	cfg *LlmConfig,
+	js jetstream.JetStream,
+) (*PromptRegistry, error) {
+	ctx := context.Background()
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  "LLM_PROMPTS",
+		History: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PromptRegistry{
+		cfg:      cfg,
+		kv:       kv,
+		versions: make(map[string]*PromptVersion),
+		active:   make(map[string]string),
+	}, nil
+}
+
+// Start seeds missing agents from the config and starts watching the bucket.
+// It returns once the current state has been loaded.
+func (r *PromptRegistry) Start(ctx context.Context) error {
+	for agent, cfg := range r.cfg.Agents() {
+		if err := r.seed(ctx, agent, cfg); err != nil {
+			return err
+		}
+	}
+
+	watcher, err := r.kv.WatchAll(context.Background())
+	if err != nil {
+		return err
+	}
+	r.watcher = watcher
+
+	loaded := make(chan struct{})
+	go func() {
+		initial := true
+		for entry := range watcher.Updates() {
+			if entry == nil {
+				if initial {
+					initial = false
+					close(loaded)
+				}
+				continue
+			}
+			r.apply(entry)
+		}
+	}()
+
+	select {
+	case <-loaded:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *PromptRegistry) Stop() error {
+	if r.watcher != nil {
+		return r.watcher.Stop()
+	}
+	return nil
+}
+
+// Prompt returns the active prompt version of an agent. Agents without an
+// active version fall back to the prompts from the config.
+func (r *PromptRegistry) Prompt(agent string, cfg *AgentConfig) *PromptVersion {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if version, ok := r.versions[r.active[agent]]; ok {
+		return version
+	}
+
+	return &PromptVersion{
+		Agent:  agent,
+		System: cfg.System,
+		User:   cfg.User,
+	}
+}
+
+// Active returns the id of the active version of an agent.
+func (r *PromptRegistry) Active(agent string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.active[agent]
+}
+
+// List returns the versions of an agent, newest first.
+func (r *PromptRegistry) List(agent string) ([]*PromptVersion, error) {
+	if _, ok := r.cfg.Agents()[agent]; !ok {
+		return nil, ErrUnknownAgent
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := make([]*PromptVersion, 0)
+	for _, version := range r.versions {
+		if version.Agent == agent {
+			versions = append(versions, version)
+		}
+	}
+	slices.SortFunc(versions, func(a, b *PromptVersion) int {
+		return strings.Compare(b.Id, a.Id)
+	})
+
+	return versions, nil
+}
+
+// Create stores a new version of an agent's prompts without activating it.
+func (r *PromptRegistry) Create(
+	ctx context.Context,
+	agent string,
+	description string,
+	system string,
+	user string,
+) (*PromptVersion, error) {
+	if _, ok := r.cfg.Agents()[agent]; !ok {
+		return nil, ErrUnknownAgent
+	}
+
+	now := time.Now()
+	version := &PromptVersion{
+		Id:          ulid.MustNew(ulid.Timestamp(now), ulid.DefaultEntropy()).String(),
+		Agent:       agent,
+		Description: description,
+		System:      system,
+		User:        user,
+		CreatedAt:   now.UTC(),
+	}
+
+	data, err := json.Marshal(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.kv.Put(ctx, versionKey(agent, version.Id), data); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.versions[version.Id] = version
+	r.mu.Unlock()
+
+	return version, nil
+}
+
+// Activate makes a version the one used by its agent on every replica.
+func (r *PromptRegistry) Activate(ctx context.Context, agent string, id string) error {
+	if _, ok := r.cfg.Agents()[agent]; !ok {
+		return ErrUnknownAgent
+	}
+
+	if _, err := r.kv.Get(ctx, versionKey(agent, id)); err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return ErrPromptVersionNotFound
+		}
+		return err
+	}
+
+	if _, err := r.kv.PutString(ctx, promptActivePrefix+agent, id); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.active[agent] = id
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *PromptRegistry) seed(ctx context.Context, agent string, cfg *AgentConfig) error {
+	_, err := r.kv.Get(ctx, promptActivePrefix+agent)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, jetstream.ErrKeyNotFound) {
+		return err
+	}
+
+	version, err := r.Create(ctx, agent, "Initial version from prompts.yaml", cfg.System, cfg.User)
+	if err != nil {
+		return err
+	}
+
+	// Another replica may have seeded the agent concurrently
+	_, err = r.kv.Create(ctx, promptActivePrefix+agent, []byte(version.Id))
+	if err != nil && !errors.Is(err, jetstream.ErrKeyExists) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PromptRegistry) apply(entry jetstream.KeyValueEntry) {
+	key := entry.Key()
+	deleted := entry.Operation() != jetstream.KeyValuePut
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(key, promptActivePrefix):
+		agent := strings.TrimPrefix(key, promptActivePrefix)
+		if deleted {
+			delete(r.active, agent)
+			return
+		}
+		r.active[agent] = string(entry.Value())
+	case strings.HasPrefix(key, promptVersionPrefix):
+		if deleted {
+			id := key[strings.LastIndex(key, ".")+1:]
+			delete(r.versions, id)
+			return
+		}
+		var version PromptVersion
+		if err := json.Unmarshal(entry.Value(), &version); err != nil {
+			return
+		}
+		r.versions[version.Id] = &version
+	}
+}
+
+func versionKey(agent string, id string) string {
+	return fmt.Sprintf("%s%s.%s", promptVersionPrefix, agent, id)
+}
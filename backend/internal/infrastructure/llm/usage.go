@@ -0,0 +1,117 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/openai/openai-go/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Usage is the token usage and cost of a single chat completion.
+type Usage struct {
+	Agent            string
+	Model            string
+	Provider         string
+	PromptTokens     int64
+	CompletionTokens int64
+	Cost             float64
+}
+
+type UsageMeter struct {
+	prices map[string]ModelPrice
+	tokens metric.Int64Counter
+	cost   metric.Float64Counter
+}
+
+func NewUsageMeter(
+	cfg *LlmConfig,
+) (*UsageMeter, error) {
+	meter := otel.Meter("llm")
+
+	tokens, err := meter.Int64Counter(
+		"llm.usage.tokens",
+		metric.WithDescription("Number of tokens consumed by LLM calls"),
+		metric.WithUnit("{token}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := meter.Float64Counter(
+		"llm.usage.cost",
+		metric.WithDescription("Cost of LLM calls"),
+		metric.WithUnit("USD"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]ModelPrice, len(cfg.Prices))
+	for _, price := range cfg.Prices {
+		prices[price.Model] = price
+	}
+
+	return &UsageMeter{
+		prices: prices,
+		tokens: tokens,
+		cost:   cost,
+	}, nil
+}
+
+// Record computes the usage of a chat completion issued by the given agent
+// against a target and emits it as metrics labelled by model and provider.
+func (m *UsageMeter) Record(
+	ctx context.Context,
+	agent string,
+	target *TargetConfig,
+	response *openai.ChatCompletion,
+) *Usage {
+	usage := &Usage{
+		Agent:            agent,
+		Model:            target.Model,
+		Provider:         Provider(target.Providers, response),
+		PromptTokens:     response.Usage.PromptTokens,
+		CompletionTokens: response.Usage.CompletionTokens,
+	}
+
+	if price, ok := m.prices[target.Model]; ok {
+		usage.Cost = (float64(usage.PromptTokens)*price.Prompt +
+			float64(usage.CompletionTokens)*price.Completion) / 1_000_000
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("agent", usage.Agent),
+		attribute.String("model", usage.Model),
+		attribute.String("provider", usage.Provider),
+	}
+
+	m.tokens.Add(ctx, usage.PromptTokens, metric.WithAttributes(
+		append(attrs, attribute.String("type", "prompt"))...,
+	))
+	m.tokens.Add(ctx, usage.CompletionTokens, metric.WithAttributes(
+		append(attrs, attribute.String("type", "completion"))...,
+	))
+	m.cost.Add(ctx, usage.Cost, metric.WithAttributes(attrs...))
+
+	return usage
+}
+
+// Provider returns the provider that served the completion as reported by
+// OpenRouter, falling back to the first of the given providers.
+func Provider(providers []string, response *openai.ChatCompletion) string {
+	if field, ok := response.JSON.ExtraFields["provider"]; ok {
+		var name string
+		if err := json.Unmarshal([]byte(field.Raw()), &name); err == nil && name != "" {
+			return name
+		}
+	}
+
+	if len(providers) > 0 {
+		return providers[0]
+	}
+
+	return ""
+}
@@ -4,6 +4,7 @@ import (
 	"backend/internal/core"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel"
@@ -177,6 +178,15 @@ func (c *NatsConsumer[T]) process(msg jetstream.Msg) {
 	span.SetStatus(codes.Ok, "event processed successfully")
 }
 
+// Pause stops delivery to the consumer on every replica until the given time.
+func (c *NatsConsumer[T]) Pause(ctx context.Context, until time.Time) error {
+	_, err := c.js.PauseConsumer(ctx, StreamName(c.channel), c.cfg.Durable, until)
+	if err != nil {
+		return fmt.Errorf("failed to pause consumer: %w", err)
+	}
+	return nil
+}
+
 func (c *NatsConsumer[T]) Stop() {
 	if c.consumer != nil {
 		c.consumer.Drain()
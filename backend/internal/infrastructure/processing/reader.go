@@ -0,0 +1,21 @@
+package processing
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Progress is the OCR progress of a file. PageCount is zero until the file is
+// rendered, it is the page count of the document, not of the stored pages.
+type Progress struct {
+	PageCount      int32
+	ProcessedPages int32
+	FailedPages    int32
+}
+
+// Reader reads the OCR progress of files, it lets the storage domain report
+// processing without depending on the OCR domain.
+type Reader interface {
+	FileProgress(ctx context.Context, tenant string, fileID pgtype.UUID) (Progress, error)
+}
@@ -0,0 +1,13 @@
+package processing
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Schemas looks up extraction schemas, it lets the storage domain validate
+// the schema of an upload without depending on the OCR domain.
+type Schemas interface {
+	SchemaExists(ctx context.Context, tenant string, id pgtype.UUID) (bool, error)
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/config"
 	"backend/openapi"
 	"fmt"
@@ -10,9 +12,13 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-func NewGatewayServeMux() *runtime.ServeMux {
+func NewGatewayServeMux(
+	cfg *config.AppConfig,
+	auth *auth.Middleware,
+) *runtime.ServeMux {
 	mux := runtime.NewServeMux(
 		runtime.WithForwardResponseOption(OtelTraceIDHeader),
+		runtime.WithMiddlewares(audit.NewClientMiddleware(cfg.Server.TrustedProxies), auth.Handler),
 	)
 
 	return mux
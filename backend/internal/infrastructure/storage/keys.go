@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+func PageImageKey(tenant string, fileKey string, pageImageKey string) string {
+	return PageImagePrefix(tenant, fileKey) + pageImageKey + ".png"
+}
+
+// RedactedPageImageKey returns the key of the redacted copy of a page image,
+// stored next to the original so it is removed along with the file.
+func RedactedPageImageKey(pageImageKey string) string {
+	return strings.TrimSuffix(pageImageKey, ".png") + ".redacted.png"
+}
+
+func IsRedactedPageImageKey(key string) bool {
+	return strings.HasSuffix(key, ".redacted.png")
+}
+
+func PageImagePrefix(tenant string, fileKey string) string {
+	return TenantKey(tenant, fmt.Sprintf("images/%s/", fileKey))
+}
+
+type ThumbnailSize string
+
+const (
+	ThumbnailSmall  ThumbnailSize = "small"
+	ThumbnailMedium ThumbnailSize = "medium"
+)
+
+// PageThumbnailKey returns the key of a page thumbnail, stored next to the
+// page image so it is removed along with the file.
+func PageThumbnailKey(pageImageKey string, size ThumbnailSize) string {
+	return fmt.Sprintf("%s.%s.jpg", strings.TrimSuffix(pageImageKey, ".png"), size)
+}
+
+// FileCoverKey returns the key of the cover thumbnail of a file, rendered
+// from its first page.
+func FileCoverKey(tenant string, fileKey string) string {
+	return PageImagePrefix(tenant, fileKey) + "cover.jpg"
+}
@@ -0,0 +1,13 @@
+package storage
+
+import "backend/internal/infrastructure/auth"
+
+// TenantKey returns the object key of key for tenant. Objects of the default
+// tenant keep unprefixed keys so files uploaded before tenants were
+// introduced stay reachable.
+func TenantKey(tenant string, key string) string {
+	if tenant == "" || tenant == auth.DefaultTenant {
+		return key
+	}
+	return "tenants/" + tenant + "/" + key
+}
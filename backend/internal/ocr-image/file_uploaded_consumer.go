@@ -2,6 +2,7 @@ package ocrimage
 
 import (
 	ocrpb "backend/gen/ocr"
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/nats"
 	"backend/internal/infrastructure/storage"
 	"backend/internal/ocr"
@@ -9,7 +10,11 @@ import (
 	"backend/internal/storage/events"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"image/png"
+	"io"
+	"log/slog"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,12 +30,14 @@ type FileUploadedConsumer struct {
 	*nats.NatsConsumer[*events.FileUploadedEvent]
 	s3       *s3.Client
 	producer *ocr.OcrProducer
+	layout   *LayoutExtractor
 }
 
 func NewFileUploadedConsumer(
 	js jetstream.JetStream,
 	s3 *s3.Client,
 	producer *ocr.OcrProducer,
+	layout *LayoutExtractor,
 ) *FileUploadedConsumer {
 	name := "ocr_file_uploaded_consumer"
 
@@ -39,6 +46,7 @@ func NewFileUploadedConsumer(
 	consumer := &FileUploadedConsumer{
 		s3:       s3,
 		producer: producer,
+		layout:   layout,
 	}
 
 	consumer.NatsConsumer = nats.NewNatsConsumer(
@@ -75,9 +83,11 @@ func (c *FileUploadedConsumer) handler(
 	)
 	defer span.End()
 
+	tenant := auth.EventTenant(event.Payload.TenantId)
+
 	// Download the file from S3 using the provided S3 client
 	result, err := c.s3.GetObject(ctx, &s3.GetObjectInput{
-		Key:    &event.Payload.FileKey,
+		Key:    aws.String(storage.TenantKey(tenant, event.Payload.FileKey)),
 		Bucket: aws.String(storage.BUCKET_NAME),
 	})
 	if err != nil {
@@ -85,13 +95,25 @@ func (c *FileUploadedConsumer) handler(
 	}
 	defer result.Body.Close()
 
+	data, err := io.ReadAll(result.Body)
+	if err != nil {
+		return err
+	}
+
 	// Transform PDF to image
-	doc, err := fitz.NewFromReader(result.Body)
+	doc, err := fitz.NewFromMemory(data)
 	if err != nil {
 		return err
 	}
 	defer doc.Close()
 
+	// Word boxes are optional, pages are still OCR'd without them
+	text, err := c.layout.ReadTextLayer(ctx, data)
+	if err != nil {
+		span.RecordError(err)
+		slog.ErrorContext(ctx, "Failed to read text layer", "error", err)
+	}
+
 	pageCount := doc.NumPage()
 
 	for pageNum := range pageCount {
@@ -99,6 +121,7 @@ func (c *FileUploadedConsumer) handler(
 		img, err := doc.Image(pageNum)
 		if err != nil {
 			span.RecordError(err)
+			c.renderFailed(ctx, event, pageNum, pageCount, err)
 			continue
 		}
 
@@ -107,15 +130,24 @@ func (c *FileUploadedConsumer) handler(
 		err = png.Encode(buf, img)
 		if err != nil {
 			span.RecordError(err)
+			c.renderFailed(ctx, event, pageNum, pageCount, err)
 			continue
 		}
 
+		contentHash := sha256.Sum256(buf.Bytes())
+
+		layout, err := c.layout.Extract(ctx, text, pageNum, img, buf.Bytes())
+		if err != nil {
+			span.RecordError(err)
+			slog.ErrorContext(ctx, "Failed to extract page layout", "page", pageNum, "error", err)
+		}
+
 		// Upload the image to S3
 		key := ulid.MustNew(
 			ulid.Timestamp(time.Now()),
 			ulid.DefaultEntropy(),
 		).String()
-		pageImageKey := ocr.PageImageKey(event.Payload.FileKey, key)
+		pageImageKey := storage.PageImageKey(tenant, event.Payload.FileKey, key)
 
 		if _, err = c.s3.PutObject(ctx, &s3.PutObjectInput{
 			Bucket:      aws.String(storage.BUCKET_NAME),
@@ -124,15 +156,27 @@ func (c *FileUploadedConsumer) handler(
 			ContentType: aws.String("image/png"),
 		}); err != nil {
 			span.RecordError(err)
+			c.renderFailed(ctx, event, pageNum, pageCount, err)
 			continue
 		}
 
+		// Thumbnails are only used for previews, pages are still OCR'd without them
+		if err := c.uploadThumbnails(ctx, img, tenant, event.Payload.FileKey, pageImageKey, pageNum); err != nil {
+			span.RecordError(err)
+			slog.ErrorContext(ctx, "Failed to upload page thumbnails", "page", pageNum, "error", err)
+		}
+
 		// Publish FilePageRenderedEvent
 		event := ocrev.NewFilePageRenderedEvent(&ocrpb.FilePageRenderedEventData{
-			PageKey:      key,
-			FileKey:      event.Payload.FileKey,
-			PageImageKey: pageImageKey,
-			PageNumber:   int32(pageNum),
+			PageKey:            key,
+			FileKey:            event.Payload.FileKey,
+			PageImageKey:       pageImageKey,
+			PageNumber:         int32(pageNum),
+			ExtractionSchemaId: event.Payload.ExtractionSchemaId,
+			PageCount:          int32(pageCount),
+			Layout:             layout,
+			ContentHash:        hex.EncodeToString(contentHash[:]),
+			TenantId:           tenant,
 		})
 
 		if err := c.producer.Publish(ctx, event); err != nil {
@@ -143,3 +187,32 @@ func (c *FileUploadedConsumer) handler(
 
 	return nil
 }
+
+// renderFailed reports a page that could not be rendered, so the OCR domain
+// records it as failed and the file can still complete.
+func (c *FileUploadedConsumer) renderFailed(
+	ctx context.Context,
+	event *events.FileUploadedEvent,
+	pageNum int,
+	pageCount int,
+	reason error,
+) {
+	key := ulid.MustNew(
+		ulid.Timestamp(time.Now()),
+		ulid.DefaultEntropy(),
+	).String()
+
+	failed := ocrev.NewFilePageRenderedEvent(&ocrpb.FilePageRenderedEventData{
+		PageKey:            key,
+		FileKey:            event.Payload.FileKey,
+		PageNumber:         int32(pageNum),
+		ExtractionSchemaId: event.Payload.ExtractionSchemaId,
+		PageCount:          int32(pageCount),
+		TenantId:           auth.EventTenant(event.Payload.TenantId),
+		RenderError:        reason.Error(),
+	})
+
+	if err := c.producer.Publish(ctx, failed); err != nil {
+		slog.ErrorContext(ctx, "Failed to report page render failure", "page", pageNum, "error", err)
+	}
+}
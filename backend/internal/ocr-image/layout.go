@@ -0,0 +1,350 @@
+package ocrimage
+
+import (
+	ocrpb "backend/gen/ocr"
+	"backend/internal/infrastructure/config"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const (
+	// go-fitz renders page images at 300 DPI, text layer positions are in
+	// points
+	renderScale = 300.0 / 72.0
+	// Pages with less text than this are treated as scanned
+	minNativeTextLength = 20
+)
+
+// LayoutExtractor produces word boxes of a page, from the PDF text layer when
+// the page has one and from tesseract otherwise.
+type LayoutExtractor struct {
+	mutool    string
+	tesseract string
+	languages string
+}
+
+// NewLayoutExtractor fails when a configured binary is not installed, so a
+// missing package stops the service at startup instead of failing every page.
+func NewLayoutExtractor(cfg *config.AppConfig) (*LayoutExtractor, error) {
+	for _, bin := range []string{cfg.Layout.Mutool, cfg.Layout.Tesseract} {
+		if bin == "" {
+			continue
+		}
+		if _, err := exec.LookPath(bin); err != nil {
+			return nil, fmt.Errorf("layout engine %q: %w", bin, err)
+		}
+	}
+
+	return &LayoutExtractor{
+		mutool:    cfg.Layout.Mutool,
+		tesseract: cfg.Layout.Tesseract,
+		languages: cfg.Layout.Languages,
+	}, nil
+}
+
+// TextLayer is the structured text of every page of a PDF.
+type TextLayer struct {
+	Pages []stextPage `xml:"page"`
+}
+
+type stextPage struct {
+	Blocks []struct {
+		Lines []stextLine `xml:"line"`
+	} `xml:"block"`
+}
+
+type stextLine struct {
+	Fonts []struct {
+		Chars []stextChar `xml:"char"`
+	} `xml:"font"`
+}
+
+type stextChar struct {
+	Quad string `xml:"quad,attr"`
+	C    string `xml:"c,attr"`
+}
+
+// ReadTextLayer reads the structured text of a PDF with mutool, which gives
+// the quad of every glyph. It is nil when mutool is not configured.
+func (e *LayoutExtractor) ReadTextLayer(ctx context.Context, pdf []byte) (*TextLayer, error) {
+	if e.mutool == "" {
+		return nil, nil
+	}
+
+	// mutool only reads documents from files
+	file, err := os.CreateTemp("", "layout-*.pdf")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(pdf)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.mutool, "draw", "-q", "-F", "stext", "-o", "-", file.Name())
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running mutool: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseTextLayer(&stdout)
+}
+
+func parseTextLayer(r io.Reader) (*TextLayer, error) {
+	text := &TextLayer{}
+	if err := xml.NewDecoder(r).Decode(text); err != nil {
+		return nil, fmt.Errorf("error parsing structured text: %w", err)
+	}
+	return text, nil
+}
+
+// Extract returns the layout of a rendered page, or nil when no engine is
+// configured for it.
+func (e *LayoutExtractor) Extract(
+	ctx context.Context,
+	text *TextLayer,
+	pageNum int,
+	img image.Image,
+	pngData []byte,
+) (*ocrpb.PageLayout, error) {
+	bounds := img.Bounds()
+
+	if text != nil && pageNum < len(text.Pages) {
+		page := text.Pages[pageNum]
+		if page.runeCount() >= minNativeTextLength {
+			return fitzLayout(page, bounds.Dx(), bounds.Dy()), nil
+		}
+	}
+
+	if e.tesseract != "" {
+		return e.tesseractLayout(ctx, pngData, bounds.Dx(), bounds.Dy())
+	}
+
+	return nil, nil
+}
+
+// runeCount returns the number of non-space glyphs of the page.
+func (p stextPage) runeCount() int {
+	count := 0
+	for _, block := range p.Blocks {
+		for _, line := range block.Lines {
+			for _, font := range line.Fonts {
+				for _, char := range font.Chars {
+					if strings.TrimSpace(char.C) != "" {
+						count++
+					}
+				}
+			}
+		}
+	}
+	return count
+}
+
+// fitzLayout groups the glyphs of every line into words, split on spaces,
+// whose boxes enclose the quads of their glyphs.
+func fitzLayout(page stextPage, width, height int) *ocrpb.PageLayout {
+	layout := &ocrpb.PageLayout{
+		Width:  int32(width),
+		Height: int32(height),
+		Source: "fitz",
+	}
+
+	for _, block := range page.Blocks {
+		for _, stext := range block.Lines {
+			line := &ocrpb.LayoutLine{}
+			var lineBox, wordBox rect
+			var word strings.Builder
+
+			flush := func() {
+				if word.Len() > 0 {
+					line.Words = append(line.Words, &ocrpb.LayoutWord{
+						Box:        wordBox.pointsBox(),
+						Text:       word.String(),
+						Confidence: 1,
+					})
+					lineBox = lineBox.union(wordBox)
+				}
+				word.Reset()
+				wordBox = rect{}
+			}
+
+			for _, font := range stext.Fonts {
+				for _, char := range font.Chars {
+					if strings.TrimSpace(char.C) == "" {
+						flush()
+						continue
+					}
+					box, ok := quadRect(char.Quad)
+					if !ok {
+						continue
+					}
+					word.WriteString(char.C)
+					wordBox = wordBox.union(box)
+				}
+			}
+			flush()
+
+			if len(line.Words) == 0 {
+				continue
+			}
+			line.Box = lineBox.pointsBox()
+			layout.Lines = append(layout.Lines, line)
+		}
+	}
+
+	return layout
+}
+
+// rect is a box in points, the zero value is empty.
+type rect struct {
+	x0, y0, x1, y1 float64
+	valid          bool
+}
+
+func (r rect) union(o rect) rect {
+	if !r.valid {
+		return o
+	}
+	if !o.valid {
+		return r
+	}
+	return rect{
+		x0:    min(r.x0, o.x0),
+		y0:    min(r.y0, o.y0),
+		x1:    max(r.x1, o.x1),
+		y1:    max(r.y1, o.y1),
+		valid: true,
+	}
+}
+
+func (r rect) pointsBox() *ocrpb.LayoutBox {
+	return &ocrpb.LayoutBox{
+		X:      int32(r.x0 * renderScale),
+		Y:      int32(r.y0 * renderScale),
+		Width:  int32((r.x1 - r.x0) * renderScale),
+		Height: int32((r.y1 - r.y0) * renderScale),
+	}
+}
+
+// quadRect returns the bounding box of a glyph quad, given as the x and y of
+// its four corners.
+func quadRect(quad string) (rect, bool) {
+	fields := strings.Fields(quad)
+	if len(fields) != 8 {
+		return rect{}, false
+	}
+
+	r := rect{}
+	for i := 0; i < len(fields); i += 2 {
+		x, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return rect{}, false
+		}
+		y, err := strconv.ParseFloat(fields[i+1], 64)
+		if err != nil {
+			return rect{}, false
+		}
+		r = r.union(rect{x0: x, y0: y, x1: x, y1: y, valid: true})
+	}
+
+	return r, true
+}
+
+// tesseractLayout runs tesseract on the page image and reads the words from
+// its TSV output.
+func (e *LayoutExtractor) tesseractLayout(
+	ctx context.Context,
+	pngData []byte,
+	width, height int,
+) (*ocrpb.PageLayout, error) {
+	args := []string{"stdin", "stdout"}
+	if e.languages != "" {
+		args = append(args, "-l", e.languages)
+	}
+	args = append(args, "tsv")
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.tesseract, args...)
+	cmd.Stdin = bytes.NewReader(pngData)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running tesseract: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	layout := &ocrpb.PageLayout{
+		Width:  int32(width),
+		Height: int32(height),
+		Source: "tesseract",
+	}
+
+	// Columns: level page_num block_num par_num line_num word_num left top
+	// width height conf text
+	var line *ocrpb.LayoutLine
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) < 12 {
+			continue
+		}
+
+		level, err := strconv.Atoi(fields[0])
+		if err != nil {
+			// Header row
+			continue
+		}
+
+		box := &ocrpb.LayoutBox{}
+		for i, v := range []*int32{&box.X, &box.Y, &box.Width, &box.Height} {
+			n, _ := strconv.Atoi(fields[6+i])
+			*v = int32(n)
+		}
+
+		switch level {
+		case 4:
+			line = &ocrpb.LayoutLine{Box: box}
+			layout.Lines = append(layout.Lines, line)
+		case 5:
+			text := strings.TrimSpace(fields[11])
+			if text == "" || line == nil {
+				continue
+			}
+			conf, _ := strconv.ParseFloat(fields[10], 32)
+			line.Words = append(line.Words, &ocrpb.LayoutWord{
+				Box:        box,
+				Text:       text,
+				Confidence: float32(max(conf, 0) / 100),
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Drop lines tesseract found no words in
+	lines := layout.Lines[:0]
+	for _, l := range layout.Lines {
+		if len(l.Words) > 0 {
+			lines = append(lines, l)
+		}
+	}
+	layout.Lines = lines
+
+	return layout, nil
+}
@@ -0,0 +1,71 @@
+package ocrimage
+
+import (
+	"backend/internal/infrastructure/imaging"
+	"backend/internal/infrastructure/storage"
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+const (
+	thumbnailQuality = 80
+	coverSize        = 512
+)
+
+var thumbnailSizes = map[storage.ThumbnailSize]int{
+	storage.ThumbnailSmall:  256,
+	storage.ThumbnailMedium: 1024,
+}
+
+// uploadThumbnails stores the small and medium thumbnails of a page next to
+// its image, and the file cover when rendering the first page.
+func (c *FileUploadedConsumer) uploadThumbnails(
+	ctx context.Context,
+	img image.Image,
+	tenant string,
+	fileKey string,
+	pageImageKey string,
+	pageNum int,
+) error {
+	for size, dimension := range thumbnailSizes {
+		if err := c.uploadThumbnail(ctx, img, dimension, storage.PageThumbnailKey(pageImageKey, size)); err != nil {
+			return err
+		}
+	}
+
+	if pageNum == 0 {
+		return c.uploadThumbnail(ctx, img, coverSize, storage.FileCoverKey(tenant, fileKey))
+	}
+
+	return nil
+}
+
+func (c *FileUploadedConsumer) uploadThumbnail(
+	ctx context.Context,
+	img image.Image,
+	size int,
+	key string,
+) error {
+	bounds := img.Bounds()
+	if max(bounds.Dx(), bounds.Dy()) > size {
+		img = imaging.Downscale(img, size)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return err
+	}
+
+	_, err := c.s3.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(storage.BUCKET_NAME),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(buf.Bytes()),
+		ContentType: aws.String("image/jpeg"),
+	})
+	return err
+}
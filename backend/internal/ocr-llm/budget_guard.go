@@ -0,0 +1,76 @@
+package ocrllm
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/infrastructure/llm"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/ocr/events"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type pausable interface {
+	Pause(ctx context.Context, until time.Time) error
+}
+
+// BudgetGuard pauses consumers once the daily LLM budget is exhausted and
+// announces it with a LlmBudgetExhaustedEvent.
+type BudgetGuard struct {
+	db      *ocrdb.Queries
+	limiter *llm.Limiter
+}
+
+func NewBudgetGuard(
+	db *ocrdb.Queries,
+	limiter *llm.Limiter,
+) *BudgetGuard {
+	return &BudgetGuard{
+		db:      db,
+		limiter: limiter,
+	}
+}
+
+// Exhausted pauses the consumer until the budget is replenished. The event is
+// emitted only once a day regardless of how many consumers hit the cap.
+func (g *BudgetGuard) Exhausted(ctx context.Context, consumer pausable) error {
+	resumeAt := g.limiter.ResetAt()
+	if err := consumer.Pause(ctx, resumeAt); err != nil {
+		return err
+	}
+
+	first, err := g.limiter.MarkExhausted(ctx)
+	if err != nil || !first {
+		return err
+	}
+
+	spent, err := g.limiter.Spent(ctx)
+	if err != nil {
+		return err
+	}
+
+	ev := events.NewLlmBudgetExhaustedEvent(
+		&ocr.LlmBudgetExhaustedEventData{
+			Date:     time.Now().UTC().Format(time.DateOnly),
+			Spent:    spent,
+			Budget:   g.limiter.Budget(),
+			ResumeAt: resumeAt.Format(time.RFC3339),
+		},
+	)
+
+	payload, err := protojson.Marshal(ev.Payload)
+	if err != nil {
+		return err
+	}
+
+	return g.db.CreateOutboxEvent(ctx, ocrdb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: ev.Id,
+			Valid: true,
+		},
+		EventType: ev.Type(),
+		Payload:   payload,
+	})
+}
@@ -0,0 +1,149 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/shared"
+	"github.com/samber/lo"
+)
+
+const unknownDocumentType = "other"
+
+type Classification struct {
+	Type     string `json:"type"`
+	Language string `json:"language"`
+	// Model and Provider are the routed target, also for cached responses
+	Model    string `json:"-"`
+	Provider string `json:"-"`
+}
+
+type ClassifierAgent struct {
+	cfg     *llm.ClassifyConfig
+	api     *openai.Client
+	cache   *llm.Cache
+	usage   *llm.UsageMeter
+	limiter *llm.Limiter
+	prompts *llm.PromptRegistry
+}
+
+func NewClassifierAgent(
+	cfg *llm.LlmConfig,
+	api *openai.Client,
+	cache *llm.Cache,
+	usage *llm.UsageMeter,
+	limiter *llm.Limiter,
+	prompts *llm.PromptRegistry,
+) *ClassifierAgent {
+	return &ClassifierAgent{
+		cfg:     &cfg.Classify,
+		api:     api,
+		cache:   cache,
+		usage:   usage,
+		limiter: limiter,
+		prompts: prompts,
+	}
+}
+
+// Pages returns how many leading pages are read to classify a document.
+func (a *ClassifierAgent) Pages() int {
+	return max(a.cfg.Pages, 1)
+}
+
+func (a *ClassifierAgent) Invoke(
+	ctx context.Context,
+	text string,
+) (*Classification, *llm.Usage, error) {
+	types := append(lo.Without(a.cfg.Types, unknownDocumentType), unknownDocumentType)
+
+	prompt := a.prompts.Prompt("classify", &a.cfg.AgentConfig)
+
+	messages := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(prompt.System),
+		openai.UserMessage(fmt.Sprintf(
+			"%s\nDocument types: %s\n\nDocument:\n%s",
+			prompt.User,
+			strings.Join(types, ", "),
+			text,
+		)),
+	}
+
+	cacheKey := llm.CacheKey{
+		Agent:         "classify",
+		Chain:         a.cfg.ChainKey(),
+		PromptVersion: prompt.Tag(),
+		ContentHash:   llm.ContentHash(fmt.Appendf(nil, "%s\x00%s", strings.Join(types, ","), text)),
+	}
+
+	var usage *llm.Usage
+	var target *llm.TargetConfig
+	response, ok := a.cache.Get(ctx, cacheKey)
+	if ok {
+		target = llm.ServedBy(&a.cfg.AgentConfig, response)
+	} else {
+		params := openai.ChatCompletionNewParams{
+			Messages: messages,
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+					JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:   "document_classification",
+						Strict: openai.Bool(true),
+						Schema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"type": map[string]any{
+									"type": "string",
+									"enum": types,
+								},
+								"language": map[string]any{
+									"type":        "string",
+									"description": "ISO 639-1 code of the main language of the document",
+								},
+							},
+							"required":             []string{"type", "language"},
+							"additionalProperties": false,
+						},
+					},
+				},
+			},
+		}
+
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		var err error
+		response, target, err = llm.Complete(ctx, a.api, &a.cfg.AgentConfig, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		usage = a.usage.Record(ctx, "classify", target, response)
+		recordLimits(ctx, a.limiter, usage)
+
+		if err := a.cache.Put(ctx, cacheKey, response); err != nil {
+			slog.ErrorContext(ctx, "Failed to cache llm response", "agent", cacheKey.Agent, "error", err)
+		}
+	}
+
+	classification := &Classification{
+		Type:     unknownDocumentType,
+		Model:    target.Model,
+		Provider: llm.Provider(target.Providers, response),
+	}
+	if len(response.Choices) > 0 {
+		if err := json.Unmarshal([]byte(response.Choices[0].Message.Content), classification); err != nil {
+			return nil, usage, fmt.Errorf("error decoding classification: %w", err)
+		}
+	}
+
+	if !lo.Contains(types, classification.Type) {
+		classification.Type = unknownDocumentType
+	}
+
+	return classification, usage, nil
+}
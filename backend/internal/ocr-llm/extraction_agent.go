@@ -0,0 +1,103 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/shared"
+)
+
+type ExtractionAgent struct {
+	cfg     *llm.AgentConfig
+	api     *openai.Client
+	cache   *llm.Cache
+	usage   *llm.UsageMeter
+	limiter *llm.Limiter
+	prompts *llm.PromptRegistry
+}
+
+func NewExtractionAgent(
+	cfg *llm.LlmConfig,
+	api *openai.Client,
+	cache *llm.Cache,
+	usage *llm.UsageMeter,
+	limiter *llm.Limiter,
+	prompts *llm.PromptRegistry,
+) *ExtractionAgent {
+	return &ExtractionAgent{
+		cfg:     &cfg.Extract,
+		api:     api,
+		cache:   cache,
+		usage:   usage,
+		limiter: limiter,
+		prompts: prompts,
+	}
+}
+
+func (a *ExtractionAgent) Invoke(
+	ctx context.Context,
+	schema []byte,
+	text string,
+) (*openai.ChatCompletion, *llm.Usage, error) {
+	var jsonSchema map[string]any
+	if err := json.Unmarshal(schema, &jsonSchema); err != nil {
+		return nil, nil, fmt.Errorf("error decoding json schema: %w", err)
+	}
+
+	prompt := a.prompts.Prompt("extract", a.cfg)
+
+	messages := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(prompt.System),
+		openai.UserMessage(fmt.Sprintf(
+			"%s\nJSON Schema:\n%s\n\nDocument page:\n%s",
+			prompt.User,
+			schema,
+			text,
+		)),
+	}
+
+	cacheKey := llm.CacheKey{
+		Agent:         "extract",
+		Chain:         a.cfg.ChainKey(),
+		PromptVersion: prompt.Tag(),
+		ContentHash:   llm.ContentHash(fmt.Appendf(nil, "%s\x00%s", schema, text)),
+	}
+
+	if cached, ok := a.cache.Get(ctx, cacheKey); ok {
+		return cached, nil, nil
+	}
+
+	params := openai.ChatCompletionNewParams{
+		Messages: messages,
+		ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+				JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   "document_extraction",
+					Schema: jsonSchema,
+				},
+			},
+		},
+	}
+
+	if err := a.limiter.Wait(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	response, target, err := llm.Complete(ctx, a.api, a.cfg, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := a.cache.Put(ctx, cacheKey, response); err != nil {
+		slog.ErrorContext(ctx, "Failed to cache llm response", "agent", cacheKey.Agent, "error", err)
+	}
+
+	usage := a.usage.Record(ctx, "extract", target, response)
+	recordLimits(ctx, a.limiter, usage)
+
+	return response, usage, nil
+}
@@ -0,0 +1,315 @@
+package ocrllm
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/nats"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/ocr/events"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FileClassifierConsumer classifies a file once its leading pages are OCR'd
+// or failed, so it listens to both outcomes of a page.
+type FileClassifierConsumer struct {
+	*nats.NatsConsumer[*events.FilePageOcrGeneratedEvent]
+	failed     *nats.NatsConsumer[*events.FilePageOcrFailedEvent]
+	db         *ocrdb.Queries
+	pool       *pgxpool.Pool
+	classifier *ClassifierAgent
+	budget     *BudgetGuard
+}
+
+func NewFileClassifierConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+	pool *pgxpool.Pool,
+	classifier *ClassifierAgent,
+	budget *BudgetGuard,
+) *FileClassifierConsumer {
+	name := "ocr_llm_file_classifier_consumer"
+	failedName := "ocr_llm_file_classifier_failed_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FileClassifierConsumer{
+		db:         db,
+		pool:       pool,
+		classifier: classifier,
+		budget:     budget,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_GENERATED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrGeneratedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR LLM File Classifier Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_GENERATED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	consumer.failed = nats.NewNatsConsumer(
+		failedName,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_FAILED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrFailedEventFromMessage,
+		consumer.failedHandler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          failedName,
+			Durable:       failedName,
+			Description:   "OCR LLM File Classifier Failed Page Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_FAILED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	return consumer
+}
+
+// Subscribe subscribes to the OCR'd and failed pages.
+func (c *FileClassifierConsumer) Subscribe(ctx context.Context) error {
+	if err := c.NatsConsumer.Subscribe(ctx); err != nil {
+		return err
+	}
+	return c.failed.Subscribe(ctx)
+}
+
+// Pause pauses both consumers until the given time.
+func (c *FileClassifierConsumer) Pause(ctx context.Context, until time.Time) error {
+	if err := c.NatsConsumer.Pause(ctx, until); err != nil {
+		return err
+	}
+	return c.failed.Pause(ctx, until)
+}
+
+// Stop stops both consumers.
+func (c *FileClassifierConsumer) Stop() {
+	c.NatsConsumer.Stop()
+	c.failed.Stop()
+}
+
+func (c *FileClassifierConsumer) handler(
+	ctx context.Context,
+	event *events.FilePageOcrGeneratedEvent,
+) error {
+	return c.classify(
+		ctx,
+		event.Payload.TenantId,
+		event.Payload.FileId,
+		event.Payload.PageNumber,
+		event.Payload.PageCount,
+	)
+}
+
+func (c *FileClassifierConsumer) failedHandler(
+	ctx context.Context,
+	event *events.FilePageOcrFailedEvent,
+) error {
+	return c.classify(
+		ctx,
+		event.Payload.TenantId,
+		event.Payload.FileId,
+		event.Payload.PageNumber,
+		event.Payload.PageCount,
+	)
+}
+
+// classify classifies the file once none of its leading pages is pending.
+// Failed pages are not retried, so the classification reads the pages that
+// were OCR'd.
+func (c *FileClassifierConsumer) classify(
+	ctx context.Context,
+	tenant string,
+	fileKey string,
+	pageNumber int32,
+	pageCount int32,
+) error {
+	pages := int32(c.classifier.Pages())
+
+	// Only the first pages are used to classify the document
+	if pageNumber >= pages {
+		return nil
+	}
+
+	// Start tracing span
+	tracer := otel.Tracer("file_classifier_consumer")
+	ctx, span := tracer.Start(
+		ctx,
+		"FileClassifierConsumer.handler",
+	)
+	defer span.End()
+
+	fileId, err := ulid.Parse(fileKey)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Cache entries are indexed under the file so they can be invalidated
+	ctx = llm.WithFile(ctx, fileId.String())
+
+	// Number of leading pages that must be OCR'd before classifying
+	required := pages
+	if pageCount > 0 {
+		required = min(pages, pageCount)
+	}
+
+	result, err := c.db.GetFirstFilePagesText(ctx, ocrdb.GetFirstFilePagesTextParams{
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		PageNumber: pages,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	done := int32(0)
+	texts := make([]string, 0, len(result))
+	for _, page := range result {
+		switch {
+		case page.TextContent != nil:
+			texts = append(texts, *page.TextContent)
+			done++
+		case page.ErrorMessage != nil:
+			done++
+		}
+	}
+
+	// Wait for the remaining leading pages
+	if done < required || len(texts) == 0 {
+		return nil
+	}
+
+	// Redelivered and concurrent events of the last leading page must not
+	// classify the file again
+	classified, err := c.db.IsFileClassified(ctx, pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if classified {
+		return nil
+	}
+
+	classification, usage, err := c.classifier.Invoke(ctx, strings.Join(texts, "\n\n"))
+	if errors.Is(err, llm.ErrBudgetExhausted) {
+		if err := c.budget.Exhausted(ctx, c); err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.String("document.type", classification.Type),
+		attribute.String("document.language", classification.Language),
+		attribute.String("llm.model", classification.Model),
+		attribute.String("llm.provider", classification.Provider),
+	)
+
+	if err := c.save(ctx, tenant, fileId, classification, usage); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// save marks the file as classified and emits the classification, unless a
+// concurrent event of the same file got there first.
+func (c *FileClassifierConsumer) save(
+	ctx context.Context,
+	tenant string,
+	fileId ulid.ULID,
+	classification *Classification,
+	usage *llm.Usage,
+) error {
+	// Begin db transaction
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := c.db.WithTx(tx)
+
+	rows, err := qtx.SetFileClassified(ctx, ocrdb.SetFileClassifiedParams{
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		TenantID: auth.EventTenant(tenant),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	// Store token usage
+	if err := saveUsage(ctx, qtx, fileId, nil, usage); err != nil {
+		return err
+	}
+
+	ev := events.NewFileClassifiedEvent(
+		&ocr.FileClassifiedEventData{
+			FileKey:      fileId.String(),
+			DocumentType: classification.Type,
+			Language:     classification.Language,
+			TenantId:     tenant,
+		},
+	)
+
+	payload, err := protojson.Marshal(ev.Payload)
+	if err != nil {
+		return err
+	}
+
+	// Save outbox event
+	err = qtx.CreateOutboxEvent(ctx, ocrdb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: ev.Id,
+			Valid: true,
+		},
+		EventType: ev.Type(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,196 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/nats"
+	"backend/internal/ocr"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/ocr/events"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type FilePageOcrGeneratedConsumer struct {
+	*nats.NatsConsumer[*events.FilePageOcrGeneratedEvent]
+	db      *ocrdb.Queries
+	extract *ExtractionAgent
+	budget  *BudgetGuard
+}
+
+func NewFilePageOcrGeneratedConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+	extract *ExtractionAgent,
+	budget *BudgetGuard,
+) *FilePageOcrGeneratedConsumer {
+	name := "ocr_llm_file_page_ocr_generated_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FilePageOcrGeneratedConsumer{
+		db:      db,
+		extract: extract,
+		budget:  budget,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_GENERATED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrGeneratedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR LLM File Page OCR Generated Event Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_GENERATED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FilePageOcrGeneratedConsumer) handler(
+	ctx context.Context,
+	event *events.FilePageOcrGeneratedEvent,
+) error {
+	// No extraction schema assigned to the file
+	if event.Payload.ExtractionSchemaId == "" {
+		return nil
+	}
+
+	// Start tracing span
+	tracer := otel.Tracer("file_page_ocr_generated_consumer")
+	ctx, span := tracer.Start(
+		ctx,
+		"FilePageOcrGeneratedConsumer.handler",
+	)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("extraction_schema_id", event.Payload.ExtractionSchemaId),
+	)
+
+	pageId, err := ulid.Parse(event.Payload.Id)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	fileId, err := ulid.Parse(event.Payload.FileId)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Cache entries are indexed under the file so they can be invalidated
+	ctx = llm.WithFile(ctx, fileId.String())
+
+	schemaId, err := uuid.Parse(event.Payload.ExtractionSchemaId)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Load extraction schema
+	schema, err := c.db.GetExtractionSchemaByID(ctx, ocrdb.GetExtractionSchemaByIDParams{
+		ID: pgtype.UUID{
+			Bytes: schemaId,
+			Valid: true,
+		},
+		TenantID: auth.EventTenant(event.Payload.TenantId),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Schema was deleted, nothing to extract
+		return nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	compiled, err := ocr.CompileExtractionSchema(schema.JsonSchema)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Load page text
+	content, err := c.db.GetFilePageContentByID(ctx, pgtype.UUID{
+		Bytes: pageId,
+		Valid: true,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+
+	// Run schema-guided extraction
+	resp, usage, err := c.extract.Invoke(ctx, schema.JsonSchema, *content)
+	if errors.Is(err, llm.ErrBudgetExhausted) {
+		if err := c.budget.Exhausted(ctx, c); err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Store token usage
+	if err := saveUsage(ctx, c.db, fileId, &pageId, usage); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	var data []byte
+	var errorMessage *string
+	if len(resp.Choices) > 0 {
+		data = []byte(resp.Choices[0].Message.Content)
+	}
+
+	// Validate result against the schema before storing it
+	if err := ocr.ValidateExtraction(compiled, data); err != nil {
+		span.RecordError(err)
+		message := err.Error()
+		errorMessage = &message
+		data = nil
+	}
+
+	// Store extraction result
+	if err := c.db.UpsertPageExtraction(ctx, ocrdb.UpsertPageExtractionParams{
+		PageID: pgtype.UUID{
+			Bytes: pageId,
+			Valid: true,
+		},
+		SchemaID: schema.ID,
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		Data:         data,
+		ErrorMessage: errorMessage,
+	}); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
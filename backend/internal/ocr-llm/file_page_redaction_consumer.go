@@ -0,0 +1,292 @@
+package ocrllm
+
+import (
+	ocrpb "backend/gen/ocr"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/nats"
+	"backend/internal/infrastructure/storage"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/ocr/events"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image/png"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+type FilePageRedactionConsumer struct {
+	*nats.NatsConsumer[*events.FilePageOcrGeneratedEvent]
+	db       *ocrdb.Queries
+	pool     *pgxpool.Pool
+	s3       *s3.Client
+	redactor *Redactor
+	budget   *BudgetGuard
+}
+
+func NewFilePageRedactionConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+	pool *pgxpool.Pool,
+	s3 *s3.Client,
+	redactor *Redactor,
+	budget *BudgetGuard,
+) *FilePageRedactionConsumer {
+	name := "ocr_llm_file_page_redaction_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FilePageRedactionConsumer{
+		db:       db,
+		pool:     pool,
+		s3:       s3,
+		redactor: redactor,
+		budget:   budget,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_GENERATED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrGeneratedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR LLM File Page Redaction Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_GENERATED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FilePageRedactionConsumer) handler(
+	ctx context.Context,
+	event *events.FilePageOcrGeneratedEvent,
+) error {
+	// Redaction is disabled
+	if !c.redactor.Enabled() {
+		return nil
+	}
+
+	// Start tracing span
+	tracer := otel.Tracer("file_page_redaction_consumer")
+	ctx, span := tracer.Start(
+		ctx,
+		"FilePageRedactionConsumer.handler",
+	)
+	defer span.End()
+
+	pageId, err := ulid.Parse(event.Payload.Id)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	fileId, err := ulid.Parse(event.Payload.FileId)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Cache entries are indexed under the file so they can be invalidated
+	ctx = llm.WithFile(ctx, fileId.String())
+
+	// Load page text
+	content, err := c.db.GetFilePageContentByID(ctx, pgtype.UUID{
+		Bytes: pageId,
+		Valid: true,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+
+	entities, usage, err := c.redactor.Detect(ctx, *content)
+	if errors.Is(err, llm.ErrBudgetExhausted) {
+		if err := c.budget.Exhausted(ctx, c); err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("redaction.entities", len(entities)))
+
+	// Find the entities on the page image when the page has word boxes
+	layout, err := c.db.GetPageLayout(ctx, pgtype.UUID{
+		Bytes: pageId,
+		Valid: true,
+	})
+	if err == nil {
+		pageLayout := &ocrpb.PageLayout{}
+		if err := proto.Unmarshal(layout.Layout, pageLayout); err != nil {
+			span.RecordError(err)
+			return err
+		}
+		LocateEntities(*content, entities, pageLayout)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		span.RecordError(err)
+		return err
+	}
+
+	// Black out the entities on the page image when their regions are known
+	var boxes []Box
+	for _, entity := range entities {
+		boxes = append(boxes, entity.Boxes...)
+	}
+
+	var imageKey *string
+	if len(boxes) > 0 {
+		key, err := c.redactImage(ctx, event.Payload.PageImageKey, boxes)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		imageKey = &key
+	}
+
+	if err := c.save(ctx, fileId, pageId, RedactText(*content, entities), imageKey, entities, usage); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// redactImage stores a copy of the page image with boxes blacked out and
+// returns its key.
+func (c *FilePageRedactionConsumer) redactImage(
+	ctx context.Context,
+	pageImageKey string,
+	boxes []Box,
+) (string, error) {
+	result, err := c.s3.GetObject(ctx, &s3.GetObjectInput{
+		Key:    &pageImageKey,
+		Bucket: aws.String(storage.BUCKET_NAME),
+	})
+	if err != nil {
+		return "", err
+	}
+	defer result.Body.Close()
+
+	img, err := png.Decode(result.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, RedactImage(img, boxes)); err != nil {
+		return "", err
+	}
+
+	key := storage.RedactedPageImageKey(pageImageKey)
+	if _, err := c.s3.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(storage.BUCKET_NAME),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(buf.Bytes()),
+		ContentType: aws.String("image/png"),
+	}); err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (c *FilePageRedactionConsumer) save(
+	ctx context.Context,
+	fileId ulid.ULID,
+	pageId ulid.ULID,
+	redacted string,
+	imageKey *string,
+	entities []Entity,
+	usage *llm.Usage,
+) error {
+	pageUUID := pgtype.UUID{
+		Bytes: pageId,
+		Valid: true,
+	}
+	fileUUID := pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	}
+
+	// Begin db transaction
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := c.db.WithTx(tx)
+
+	// Replace the entities of a previous run
+	if err := qtx.DeletePageEntitiesByPageID(ctx, pageUUID); err != nil {
+		return err
+	}
+
+	for _, entity := range entities {
+		var boxes []byte
+		if len(entity.Boxes) > 0 {
+			boxes, err = json.Marshal(entity.Boxes)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = qtx.CreatePageEntity(ctx, ocrdb.CreatePageEntityParams{
+			ID: pgtype.UUID{
+				Bytes: ulid.MustNew(ulid.Timestamp(time.Now()), ulid.DefaultEntropy()),
+				Valid: true,
+			},
+			PageID:      pageUUID,
+			FileID:      fileUUID,
+			EntityType:  entity.Type,
+			Detector:    entity.Detector,
+			StartOffset: int32(entity.Start),
+			EndOffset:   int32(entity.End),
+			Boxes:       boxes,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = qtx.UpsertPageRedaction(ctx, ocrdb.UpsertPageRedactionParams{
+		PageID:      pageUUID,
+		FileID:      fileUUID,
+		TextContent: redacted,
+		ImageKey:    imageKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Store token usage
+	if err := saveUsage(ctx, qtx, fileId, &pageId, usage); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
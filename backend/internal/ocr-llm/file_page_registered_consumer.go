@@ -2,15 +2,13 @@ package ocrllm
 
 import (
 	"backend/gen/ocr"
+	"backend/internal/infrastructure/llm"
 	"backend/internal/infrastructure/nats"
-	"backend/internal/infrastructure/storage"
 	ocrdb "backend/internal/ocr/db"
 	"backend/internal/ocr/events"
 	"context"
-	"io"
+	"errors"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nats-io/nats.go/jetstream"
@@ -21,10 +19,11 @@ import (
 
 type FilePageRegisteredConsumer struct {
 	*nats.NatsConsumer[*events.FilePageRegisteredEvent]
-	db   *ocrdb.Queries
-	pool *pgxpool.Pool
-	ocr  *OcrAgent
-	s3   *s3.Client
+	db     *ocrdb.Queries
+	pool   *pgxpool.Pool
+	ocr    *OcrAgent
+	images *PageImageLoader
+	budget *BudgetGuard
 }
 
 func NewFilePageRegisteredConsumer(
@@ -32,17 +31,19 @@ func NewFilePageRegisteredConsumer(
 	db *ocrdb.Queries,
 	pool *pgxpool.Pool,
 	ocr *OcrAgent,
-	s3 *s3.Client,
+	images *PageImageLoader,
+	budget *BudgetGuard,
 ) *FilePageRegisteredConsumer {
 	name := "ocr_file_page_registered_consumer"
 	numWorkers := 4
 	workerBufferSize := 20
 
 	consumer := &FilePageRegisteredConsumer{
-		db:   db,
-		pool: pool,
-		ocr:  ocr,
-		s3:   s3,
+		db:     db,
+		pool:   pool,
+		ocr:    ocr,
+		images: images,
+		budget: budget,
 	}
 
 	consumer.NatsConsumer = nats.NewNatsConsumer(
@@ -85,29 +86,35 @@ func (c *FilePageRegisteredConsumer) handler(
 		return err
 	}
 
-	// Get page image key
-	pageKey := event.Payload.PageImageKey
-
-	// Fetch image from S3
-	result, err := c.s3.GetObject(ctx, &s3.GetObjectInput{
-		Key:    &pageKey,
-		Bucket: aws.String(storage.BUCKET_NAME),
-	})
+	fileId, err := ulid.Parse(event.Payload.FileId)
 	if err != nil {
 		span.RecordError(err)
 		return err
 	}
-	defer result.Body.Close()
 
-	// Get image data
-	data, err := io.ReadAll(result.Body)
+	// Cache entries are indexed under the file so they can be invalidated
+	ctx = llm.WithFile(ctx, fileId.String())
+
+	// Fetch image from S3, pages over the size ceiling are failed for good
+	image, err := c.images.Load(ctx, event.Payload.PageImageKey)
+	if errors.Is(err, ErrImageTooLarge) {
+		span.RecordError(err)
+		return c.fail(ctx, event, id, err)
+	}
 	if err != nil {
 		span.RecordError(err)
 		return err
 	}
+	defer image.Release()
 
 	// Generate OCR
-	resp, err := c.ocr.Invoke(ctx, data)
+	ocrResult, err := c.ocr.Invoke(ctx, image)
+	if errors.Is(err, llm.ErrBudgetExhausted) {
+		if err := c.budget.Exhausted(ctx, c); err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
 	if err != nil {
 		span.RecordError(err)
 		return err
@@ -115,8 +122,8 @@ func (c *FilePageRegisteredConsumer) handler(
 
 	// Extract OCR text
 	textContent := "Not recognized"
-	if len(resp.Choices) > 0 {
-		textContent = resp.Choices[0].Message.Content
+	if len(ocrResult.Response.Choices) > 0 {
+		textContent = ocrResult.Response.Choices[0].Message.Content
 	}
 
 	// Begin db transaction
@@ -128,14 +135,28 @@ func (c *FilePageRegisteredConsumer) handler(
 	defer tx.Rollback(ctx)
 	qtx := c.db.WithTx(tx)
 
-	// Store OCR result in DB
-	if err := qtx.UpdateFilePageText(ctx, ocrdb.UpdateFilePageTextParams{
+	// Store OCR result in DB along with the target and prompt that produced it
+	params := ocrdb.UpdateFilePageTextParams{
 		ID: pgtype.UUID{
 			Bytes: id,
 			Valid: true,
 		},
 		TextContent: &textContent,
-	}); err != nil {
+		OcrModel:    &ocrResult.Model,
+	}
+	if ocrResult.Provider != "" {
+		params.OcrProvider = &ocrResult.Provider
+	}
+	if ocrResult.PromptVersion != "" {
+		params.PromptVersion = &ocrResult.PromptVersion
+	}
+	if err := qtx.UpdateFilePageText(ctx, params); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Store token usage
+	if err := saveUsage(ctx, qtx, fileId, &id, ocrResult.Usage); err != nil {
 		span.RecordError(err)
 		return err
 	}
@@ -143,10 +164,13 @@ func (c *FilePageRegisteredConsumer) handler(
 	// Create FilePageOcrGeneratedEvent
 	ev := events.NewFilePageOcrGeneratedEvent(
 		&ocr.FilePageOcrGeneratedEventData{
-			Id:           event.Payload.Id,
-			FileId:       event.Payload.FileId,
-			PageNumber:   event.Payload.PageNumber,
-			PageImageKey: event.Payload.PageImageKey,
+			Id:                 event.Payload.Id,
+			FileId:             event.Payload.FileId,
+			PageNumber:         event.Payload.PageNumber,
+			PageImageKey:       event.Payload.PageImageKey,
+			ExtractionSchemaId: event.Payload.ExtractionSchemaId,
+			PageCount:          event.Payload.PageCount,
+			TenantId:           event.Payload.TenantId,
 		},
 	)
 
@@ -176,3 +200,65 @@ func (c *FilePageRegisteredConsumer) handler(
 
 	return nil
 }
+
+// fail records why the page could not be OCRed and emits a
+// FilePageOcrFailedEvent instead of retrying.
+func (c *FilePageRegisteredConsumer) fail(
+	ctx context.Context,
+	event *events.FilePageRegisteredEvent,
+	id ulid.ULID,
+	reason error,
+) error {
+	message := reason.Error()
+
+	// Begin db transaction
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := c.db.WithTx(tx)
+
+	err = qtx.UpdateFilePageError(ctx, ocrdb.UpdateFilePageErrorParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		ErrorMessage: &message,
+	})
+	if err != nil {
+		return err
+	}
+
+	ev := events.NewFilePageOcrFailedEvent(
+		&ocr.FilePageOcrFailedEventData{
+			Id:           event.Payload.Id,
+			FileId:       event.Payload.FileId,
+			PageNumber:   event.Payload.PageNumber,
+			PageImageKey: event.Payload.PageImageKey,
+			Reason:       message,
+			TenantId:     event.Payload.TenantId,
+			PageCount:    event.Payload.PageCount,
+		},
+	)
+
+	payload, err := protojson.Marshal(ev.Payload)
+	if err != nil {
+		return err
+	}
+
+	// Save outbox event
+	err = qtx.CreateOutboxEvent(ctx, ocrdb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: ev.Id,
+			Valid: true,
+		},
+		EventType: ev.Type(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,180 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/nats"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/ocr/events"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type FilePageTranslationConsumer struct {
+	*nats.NatsConsumer[*events.FilePageOcrGeneratedEvent]
+	db        *ocrdb.Queries
+	pool      *pgxpool.Pool
+	translate *TranslationAgent
+	budget    *BudgetGuard
+}
+
+func NewFilePageTranslationConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+	pool *pgxpool.Pool,
+	translate *TranslationAgent,
+	budget *BudgetGuard,
+) *FilePageTranslationConsumer {
+	name := "ocr_llm_file_page_translation_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FilePageTranslationConsumer{
+		db:        db,
+		pool:      pool,
+		translate: translate,
+		budget:    budget,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_GENERATED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrGeneratedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR LLM File Page Translation Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_GENERATED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FilePageTranslationConsumer) handler(
+	ctx context.Context,
+	event *events.FilePageOcrGeneratedEvent,
+) error {
+	// Translation is disabled
+	languages := c.translate.Languages()
+	if len(languages) == 0 {
+		return nil
+	}
+
+	// Start tracing span
+	tracer := otel.Tracer("file_page_translation_consumer")
+	ctx, span := tracer.Start(
+		ctx,
+		"FilePageTranslationConsumer.handler",
+	)
+	defer span.End()
+
+	pageId, err := ulid.Parse(event.Payload.Id)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	fileId, err := ulid.Parse(event.Payload.FileId)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Cache entries are indexed under the file so they can be invalidated
+	ctx = llm.WithFile(ctx, fileId.String())
+
+	// Load page text
+	content, err := c.db.GetFilePageContentByID(ctx, pgtype.UUID{
+		Bytes: pageId,
+		Valid: true,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if content == nil {
+		return nil
+	}
+
+	// Translations already stored are served from the LLM cache when the
+	// event is redelivered, so every language is processed each time
+	for _, language := range languages {
+		span.SetAttributes(attribute.String("language", language))
+
+		result, err := c.translate.Invoke(ctx, language, *content)
+		if errors.Is(err, llm.ErrBudgetExhausted) {
+			if err := c.budget.Exhausted(ctx, c); err != nil {
+				span.RecordError(err)
+			}
+			return err
+		}
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		if err := c.save(ctx, fileId, pageId, language, result); err != nil {
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *FilePageTranslationConsumer) save(
+	ctx context.Context,
+	fileId ulid.ULID,
+	pageId ulid.ULID,
+	language string,
+	result *TranslationResult,
+) error {
+	// Begin db transaction
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := c.db.WithTx(tx)
+
+	params := ocrdb.UpsertPageTranslationParams{
+		PageID: pgtype.UUID{
+			Bytes: pageId,
+			Valid: true,
+		},
+		Language: language,
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		TextContent: result.Text,
+		Model:       &result.Model,
+		Provider:    &result.Provider,
+	}
+	if result.PromptVersion != "" {
+		params.PromptVersion = &result.PromptVersion
+	}
+	if err := qtx.UpsertPageTranslation(ctx, params); err != nil {
+		return err
+	}
+
+	// Store token usage
+	if err := saveUsage(ctx, qtx, fileId, &pageId, result.Usage); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,299 @@
+package ocrllm
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/nats"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/ocr/events"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FileSummarizerConsumer summarizes a file once every page is OCR'd or
+// failed, so it listens to both outcomes of a page.
+type FileSummarizerConsumer struct {
+	*nats.NatsConsumer[*events.FilePageOcrGeneratedEvent]
+	failed     *nats.NatsConsumer[*events.FilePageOcrFailedEvent]
+	db         *ocrdb.Queries
+	pool       *pgxpool.Pool
+	summarizer *SummaryAgent
+	budget     *BudgetGuard
+}
+
+func NewFileSummarizerConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+	pool *pgxpool.Pool,
+	summarizer *SummaryAgent,
+	budget *BudgetGuard,
+) *FileSummarizerConsumer {
+	name := "ocr_llm_file_summarizer_consumer"
+	failedName := "ocr_llm_file_summarizer_failed_consumer"
+	numWorkers := 2
+	workerBufferSize := 20
+
+	consumer := &FileSummarizerConsumer{
+		db:         db,
+		pool:       pool,
+		summarizer: summarizer,
+		budget:     budget,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_GENERATED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrGeneratedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR LLM File Summarizer Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_GENERATED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	consumer.failed = nats.NewNatsConsumer(
+		failedName,
+		events.OCR_CHANNEL,
+		events.FILE_PAGE_OCR_FAILED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilePageOcrFailedEventFromMessage,
+		consumer.failedHandler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          failedName,
+			Durable:       failedName,
+			Description:   "OCR LLM File Summarizer Failed Page Consumer",
+			FilterSubject: events.FILE_PAGE_OCR_FAILED_EVENT,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+		},
+	)
+
+	return consumer
+}
+
+// Subscribe subscribes to the OCR'd and failed pages.
+func (c *FileSummarizerConsumer) Subscribe(ctx context.Context) error {
+	if err := c.NatsConsumer.Subscribe(ctx); err != nil {
+		return err
+	}
+	return c.failed.Subscribe(ctx)
+}
+
+// Pause pauses both consumers until the given time.
+func (c *FileSummarizerConsumer) Pause(ctx context.Context, until time.Time) error {
+	if err := c.NatsConsumer.Pause(ctx, until); err != nil {
+		return err
+	}
+	return c.failed.Pause(ctx, until)
+}
+
+// Stop stops both consumers.
+func (c *FileSummarizerConsumer) Stop() {
+	c.NatsConsumer.Stop()
+	c.failed.Stop()
+}
+
+func (c *FileSummarizerConsumer) handler(
+	ctx context.Context,
+	event *events.FilePageOcrGeneratedEvent,
+) error {
+	return c.summarize(ctx, event.Payload.TenantId, event.Payload.FileId, event.Payload.PageCount)
+}
+
+func (c *FileSummarizerConsumer) failedHandler(
+	ctx context.Context,
+	event *events.FilePageOcrFailedEvent,
+) error {
+	return c.summarize(ctx, event.Payload.TenantId, event.Payload.FileId, event.Payload.PageCount)
+}
+
+// summarize summarizes the file once none of its pages is pending. Failed
+// pages are not retried, so the summary covers the pages that were OCR'd.
+func (c *FileSummarizerConsumer) summarize(
+	ctx context.Context,
+	tenant string,
+	fileKey string,
+	pageCount int32,
+) error {
+	// The page count is needed to know when the whole document is OCR'd
+	if pageCount == 0 || pageCount < int32(c.summarizer.MinPages()) {
+		return nil
+	}
+
+	// Start tracing span
+	tracer := otel.Tracer("file_summarizer_consumer")
+	ctx, span := tracer.Start(
+		ctx,
+		"FileSummarizerConsumer.handler",
+	)
+	defer span.End()
+
+	fileId, err := ulid.Parse(fileKey)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Cache entries are indexed under the file so they can be invalidated
+	ctx = llm.WithFile(ctx, fileId.String())
+
+	result, err := c.db.GetFirstFilePagesText(ctx, ocrdb.GetFirstFilePagesTextParams{
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		PageNumber: pageCount,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	done := 0
+	pages := make([]string, 0, len(result))
+	for _, page := range result {
+		switch {
+		case page.TextContent != nil:
+			pages = append(pages, *page.TextContent)
+			done++
+		case page.ErrorMessage != nil:
+			done++
+		}
+	}
+
+	// Wait for the remaining pages
+	if int32(done) < pageCount || len(pages) == 0 {
+		return nil
+	}
+
+	// Redelivered and concurrent events of the last page must not summarize
+	// the file again
+	existing, err := c.db.GetFileSummary(ctx, pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	})
+	if err == nil && existing.PageCount == pageCount {
+		return nil
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.Int("document.pages", len(pages)),
+		attribute.Int("document.failed_pages", done-len(pages)),
+	)
+
+	summary, err := c.summarizer.Invoke(ctx, pages)
+	if errors.Is(err, llm.ErrBudgetExhausted) {
+		if err := c.budget.Exhausted(ctx, c); err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.save(ctx, tenant, fileId, pageCount, summary); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *FileSummarizerConsumer) save(
+	ctx context.Context,
+	tenant string,
+	fileId ulid.ULID,
+	pageCount int32,
+	summary *SummaryResult,
+) error {
+	// Begin db transaction
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := c.db.WithTx(tx)
+
+	params := ocrdb.UpsertFileSummaryParams{
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		Title:     summary.Title,
+		Summary:   summary.Summary.Summary,
+		PageCount: pageCount,
+		Model:     &summary.Model,
+	}
+	if summary.PromptVersion != "" {
+		params.PromptVersion = &summary.PromptVersion
+	}
+	if err := qtx.UpsertFileSummary(ctx, params); err != nil {
+		return err
+	}
+
+	// Store token usage
+	for _, usage := range summary.Usage {
+		if err := saveUsage(ctx, qtx, fileId, nil, usage); err != nil {
+			return err
+		}
+	}
+
+	// An empty title must not overwrite the display title
+	if strings.TrimSpace(summary.Title) == "" {
+		return tx.Commit(ctx)
+	}
+
+	// Let the storage domain update the display title
+	ev := events.NewFileSummarizedEvent(
+		&ocr.FileSummarizedEventData{
+			FileKey:  fileId.String(),
+			Title:    summary.Title,
+			TenantId: tenant,
+		},
+	)
+
+	payload, err := protojson.Marshal(ev.Payload)
+	if err != nil {
+		return err
+	}
+
+	// Save outbox event
+	err = qtx.CreateOutboxEvent(ctx, ocrdb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: ev.Id,
+			Valid: true,
+		},
+		EventType: ev.Type(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
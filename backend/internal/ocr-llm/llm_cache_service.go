@@ -0,0 +1,196 @@
+package ocrllm
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/service"
+	"backend/internal/infrastructure/storage"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LlmCacheService struct {
+	ocr.UnimplementedLlmCacheServiceServer
+	cache  *llm.Cache
+	s3     *s3.Client
+	images *PageImageLoader
+}
+
+var _ ocr.LlmCacheServiceServer = (*LlmCacheService)(nil)
+var _ service.Service = (*LlmCacheService)(nil)
+
+func NewLlmCacheService(
+	cache *llm.Cache,
+	s3 *s3.Client,
+	images *PageImageLoader,
+) *LlmCacheService {
+	return &LlmCacheService{
+		cache:  cache,
+		s3:     s3,
+		images: images,
+	}
+}
+
+// GetCacheStats implements ocr.LlmCacheServiceServer.
+func (l *LlmCacheService) GetCacheStats(
+	ctx context.Context,
+	req *ocr.GetCacheStatsRequest,
+) (*ocr.GetCacheStatsResponse, error) {
+	stats, err := l.cache.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ocr.GetCacheStatsResponse{
+		Entries: stats.Entries,
+		Bytes:   stats.Bytes,
+		Ttl:     stats.TTL.String(),
+	}, nil
+}
+
+// InspectFileCache implements ocr.LlmCacheServiceServer.
+func (l *LlmCacheService) InspectFileCache(
+	ctx context.Context,
+	req *ocr.InspectFileCacheRequest,
+) (*ocr.InspectFileCacheResponse, error) {
+	pages := make([]*ocr.CachedPage, 0)
+	_, err := l.eachPageImage(ctx, req.FileKey, func(key string, hash string) error {
+		entries, err := l.cache.ContentEntries(ctx, "ocr", hash)
+		if err != nil {
+			return err
+		}
+
+		page := &ocr.CachedPage{
+			PageImageKey: key,
+			ImageSha256:  hash,
+			Entries:      make([]*ocr.CacheEntry, len(entries)),
+		}
+		for i, entry := range entries {
+			page.Entries[i] = &ocr.CacheEntry{
+				Key:       entry.Key,
+				Size:      int32(entry.Size),
+				CreatedAt: entry.CreatedAt.UTC().Format(time.RFC3339),
+			}
+		}
+		pages = append(pages, page)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ocr.InspectFileCacheResponse{
+		Pages: pages,
+	}, nil
+}
+
+// InvalidateFileCache implements ocr.LlmCacheServiceServer.
+func (l *LlmCacheService) InvalidateFileCache(
+	ctx context.Context,
+	req *ocr.InvalidateFileCacheRequest,
+) (*ocr.InvalidateCacheResponse, error) {
+	var deleted int
+	images, err := l.eachPageImage(ctx, req.FileKey, func(key string, hash string) error {
+		n, err := l.cache.InvalidateContent(ctx, "ocr", hash)
+		deleted += n
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The other agents are found through the file index, only for files the
+	// caller's tenant has page images of
+	if images > 0 {
+		n, err := l.cache.InvalidateFile(ctx, req.FileKey)
+		deleted += n
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ocr.InvalidateCacheResponse{
+		Deleted: int32(deleted),
+	}, nil
+}
+
+// InvalidatePromptVersionCache implements ocr.LlmCacheServiceServer.
+func (l *LlmCacheService) InvalidatePromptVersionCache(
+	ctx context.Context,
+	req *ocr.InvalidatePromptVersionCacheRequest,
+) (*ocr.InvalidateCacheResponse, error) {
+	if req.PromptVersion == "" || strings.ContainsAny(req.PromptVersion, ".*> ") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid prompt version")
+	}
+
+	deleted, err := l.cache.InvalidatePromptVersion(ctx, req.PromptVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ocr.InvalidateCacheResponse{
+		Deleted: int32(deleted),
+	}, nil
+}
+
+// Register implements service.Service.
+func (l *LlmCacheService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	ocr.RegisterLlmCacheServiceHandlerServer(ctx, mux, l)
+}
+
+// eachPageImage calls fn with the key and hash of every page image of a file
+// and returns how many there were. Cache entries are keyed by image content,
+// so the hashes are needed to find them.
+func (l *LlmCacheService) eachPageImage(
+	ctx context.Context,
+	fileKey string,
+	fn func(key string, hash string) error,
+) (int, error) {
+	if _, err := ulid.Parse(fileKey); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(l.s3, &s3.ListObjectsV2Input{
+		Bucket: aws.String(storage.BUCKET_NAME),
+		Prefix: aws.String(storage.PageImagePrefix(auth.Tenant(ctx), fileKey)),
+	})
+
+	count := 0
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return count, fmt.Errorf("error listing page images for %s: %w", fileKey, err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".png") || storage.IsRedactedPageImageKey(*obj.Key) {
+				continue
+			}
+
+			hash, err := l.images.Hash(ctx, *obj.Key)
+			if err != nil {
+				return count, err
+			}
+
+			key := strings.TrimSuffix(path.Base(*obj.Key), ".png")
+			if err := fn(key, hash); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+
+	return count, nil
+}
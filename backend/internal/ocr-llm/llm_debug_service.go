@@ -2,20 +2,22 @@ package ocrllm
 
 import (
 	"backend/gen/ocr"
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/service"
 	"backend/internal/infrastructure/storage"
 	"context"
-	"io"
+	"errors"
+	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type LlmDebugService struct {
 	ocr.UnimplementedLlmDebugServiceServer
-	ocr *OcrAgent
-	s3  *s3.Client
+	ocr    *OcrAgent
+	images *PageImageLoader
 }
 
 var _ ocr.LlmDebugServiceServer = (*LlmDebugService)(nil)
@@ -23,11 +25,11 @@ var _ service.Service = (*LlmDebugService)(nil)
 
 func NewLlmDebugService(
 	ocr *OcrAgent,
-	s3 *s3.Client,
+	images *PageImageLoader,
 ) *LlmDebugService {
 	return &LlmDebugService{
-		ocr: ocr,
-		s3:  s3,
+		ocr:    ocr,
+		images: images,
 	}
 }
 
@@ -36,29 +38,29 @@ func (l *LlmDebugService) GetOcr(
 	ctx context.Context,
 	req *ocr.GetOcrRequest,
 ) (*ocr.GetOcrResponse, error) {
-	// Fetch image from S3
-	result, err := l.s3.GetObject(ctx, &s3.GetObjectInput{
-		Key:    &req.PageKey,
-		Bucket: aws.String(storage.BUCKET_NAME),
-	})
-	if err != nil {
-		return nil, err
+	// Only page images of the caller's tenant can be read
+	if !strings.HasPrefix(req.PageKey, storage.TenantKey(auth.Tenant(ctx), "images/")) {
+		return nil, status.Error(codes.NotFound, "page image not found")
 	}
-	defer result.Body.Close()
 
-	data, err := io.ReadAll(result.Body)
+	// Fetch image from S3
+	image, err := l.images.Load(ctx, req.PageKey)
+	if errors.Is(err, ErrImageTooLarge) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
+	defer image.Release()
 
-	resp, err := l.ocr.Invoke(ctx, data)
+	ocrResult, err := l.ocr.Invoke(ctx, image)
 	if err != nil {
 		return nil, err
 	}
 
 	ocrText := "Not recognized"
-	if len(resp.Choices) > 0 {
-		ocrText = resp.Choices[0].Message.Content
+	if len(ocrResult.Response.Choices) > 0 {
+		ocrText = ocrResult.Response.Choices[0].Message.Content
 	}
 
 	return &ocr.GetOcrResponse{
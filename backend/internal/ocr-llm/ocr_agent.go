@@ -4,84 +4,128 @@ import (
 	"backend/internal/infrastructure/llm"
 	"context"
 	"encoding/base64"
-	"encoding/json"
-	"fmt"
+	"log/slog"
+	"strings"
 
-	"github.com/nats-io/nats.go/jetstream"
 	"github.com/openai/openai-go/v3"
 )
 
+type OcrResult struct {
+	Response *openai.ChatCompletion
+	// Usage is nil when the response was served from the cache
+	Usage *llm.Usage
+	// Model and Provider are the routed target, also for cached responses
+	Model         string
+	Provider      string
+	PromptVersion string
+}
+
 type OcrAgent struct {
-	cfg *llm.AgentConfig
-	api *openai.Client
-	kv  jetstream.KeyValue
+	cfg     *llm.AgentConfig
+	api     *openai.Client
+	cache   *llm.Cache
+	usage   *llm.UsageMeter
+	limiter *llm.Limiter
+	prompts *llm.PromptRegistry
 }
 
 func NewOcrAgent(
 	cfg *llm.LlmConfig,
 	api *openai.Client,
-	kv jetstream.KeyValue,
+	cache *llm.Cache,
+	usage *llm.UsageMeter,
+	limiter *llm.Limiter,
+	prompts *llm.PromptRegistry,
 ) *OcrAgent {
 	return &OcrAgent{
-		cfg: &cfg.Ocr,
-		api: api,
-		kv:  kv,
+		cfg:     &cfg.Ocr,
+		api:     api,
+		cache:   cache,
+		usage:   usage,
+		limiter: limiter,
+		prompts: prompts,
 	}
 }
 
-func (a *OcrAgent) Invoke(ctx context.Context, input []byte) (*openai.ChatCompletion, error) {
-	image := base64.StdEncoding.EncodeToString(input)
+func (a *OcrAgent) Invoke(ctx context.Context, image *PageImage) (*OcrResult, error) {
+	prompt := a.prompts.Prompt("ocr", a.cfg)
 
 	messages := []openai.ChatCompletionMessageParamUnion{
-		openai.SystemMessage(a.cfg.System),
+		openai.SystemMessage(prompt.System),
 		openai.UserMessage([]openai.ChatCompletionContentPartUnionParam{
 			{
 				OfText: &openai.ChatCompletionContentPartTextParam{
-					Text: a.cfg.User,
+					Text: prompt.User,
 				},
 			},
 			{
 				OfImageURL: &openai.ChatCompletionContentPartImageParam{
 					ImageURL: openai.ChatCompletionContentPartImageImageURLParam{
-						URL: fmt.Sprintf("data:image/png;base64,%s", image),
+						URL: dataURL(image),
 					},
 				},
 			},
 		}),
 	}
 
-	cacheKey := llm.GenerateCacheKey(llm.CacheKey{
-		Model:    a.cfg.Model,
-		Messages: messages,
-	})
-
-	entry, err := a.kv.Get(ctx, cacheKey)
-	if err == nil {
-		var cachedResponse openai.ChatCompletion
-		if err := json.Unmarshal(entry.Value(), &cachedResponse); err == nil {
-			return &cachedResponse, nil
-		}
+	cacheKey := llm.CacheKey{
+		Agent:         "ocr",
+		Chain:         a.cfg.ChainKey(),
+		PromptVersion: prompt.Tag(),
+		ContentHash:   image.Hash,
+	}
+
+	if cached, ok := a.cache.Get(ctx, cacheKey); ok {
+		target := llm.ServedBy(a.cfg, cached)
+		return &OcrResult{
+			Response:      cached,
+			Model:         target.Model,
+			Provider:      llm.Provider(target.Providers, cached),
+			PromptVersion: prompt.Id,
+		}, nil
 	}
 
 	params := openai.ChatCompletionNewParams{
-		Model:    a.cfg.Model,
 		Messages: messages,
 	}
 
-	params.SetExtraFields(map[string]any{
-		"provider": map[string]any{
-			"order":           a.cfg.Providers,
-			"allow_fallbacks": false,
-		},
-	})
+	if err := a.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
-	response, err := a.api.Chat.Completions.New(ctx, params)
+	response, target, err := llm.Complete(ctx, a.api, a.cfg, params)
 	if err != nil {
-		return nil, fmt.Errorf("error creating chat completion: %w", err)
+		return nil, err
 	}
 
-	data, _ := json.Marshal(response)
-	a.kv.Put(ctx, cacheKey, data)
+	if err := a.cache.Put(ctx, cacheKey, response); err != nil {
+		slog.ErrorContext(ctx, "Failed to cache llm response", "agent", cacheKey.Agent, "error", err)
+	}
+
+	usage := a.usage.Record(ctx, "ocr", target, response)
+	recordLimits(ctx, a.limiter, usage)
+
+	return &OcrResult{
+		Response:      response,
+		Usage:         usage,
+		Model:         usage.Model,
+		Provider:      usage.Provider,
+		PromptVersion: prompt.Id,
+	}, nil
+}
+
+// dataURL base64-encodes the image straight into the URL so the encoded copy
+// is the only allocation besides the image itself.
+func dataURL(image *PageImage) string {
+	prefix := "data:" + image.MimeType + ";base64,"
+
+	var url strings.Builder
+	url.Grow(len(prefix) + base64.StdEncoding.EncodedLen(len(image.Data)))
+	url.WriteString(prefix)
+
+	encoder := base64.NewEncoder(base64.StdEncoding, &url)
+	encoder.Write(image.Data)
+	encoder.Close()
 
-	return response, nil
+	return url.String()
 }
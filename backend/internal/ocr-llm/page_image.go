@@ -0,0 +1,238 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/imaging"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/storage"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+const (
+	defaultImageMaxBytes     = 20 << 20
+	defaultImageMaxPixels    = 50_000_000
+	defaultImageTargetBytes  = 4 << 20
+	defaultImageMaxDimension = 2048
+	recompressQuality        = 85
+)
+
+var ErrImageTooLarge = errors.New("page image exceeds the size limit")
+
+// PageImage is a page image ready to be sent to the LLM. Data is backed by a
+// pooled buffer and must not be used after Release.
+type PageImage struct {
+	Data     []byte
+	MimeType string
+	// Hash is the hex SHA-256 of the original image as stored in S3
+	Hash string
+
+	release func()
+}
+
+// NewPageImage wraps an image that is already in memory and is sent as is.
+func NewPageImage(data []byte, mimeType string) *PageImage {
+	return &PageImage{
+		Data:     data,
+		MimeType: mimeType,
+		Hash:     llm.ContentHash(data),
+	}
+}
+
+func (p *PageImage) Release() {
+	if p.release != nil {
+		p.release()
+		p.release = nil
+	}
+}
+
+// PageImageLoader reads page images from S3 with a hard byte ceiling and
+// shrinks images above the target size before they are base64-encoded.
+type PageImageLoader struct {
+	s3           *s3.Client
+	maxBytes     int64
+	maxPixels    int64
+	targetBytes  int64
+	maxDimension int
+	buffers      sync.Pool
+}
+
+func NewPageImageLoader(
+	cfg *config.AppConfig,
+	s3 *s3.Client,
+) *PageImageLoader {
+	images := cfg.LLM.Images
+
+	loader := &PageImageLoader{
+		s3:           s3,
+		maxBytes:     images.MaxBytes,
+		maxPixels:    images.MaxPixels,
+		targetBytes:  images.TargetBytes,
+		maxDimension: images.MaxDimension,
+		buffers: sync.Pool{
+			New: func() any {
+				return new(bytes.Buffer)
+			},
+		},
+	}
+	if loader.maxBytes <= 0 {
+		loader.maxBytes = defaultImageMaxBytes
+	}
+	if loader.maxPixels <= 0 {
+		loader.maxPixels = defaultImageMaxPixels
+	}
+	if loader.targetBytes <= 0 {
+		loader.targetBytes = defaultImageTargetBytes
+	}
+	if loader.maxDimension <= 0 {
+		loader.maxDimension = defaultImageMaxDimension
+	}
+
+	return loader
+}
+
+// Load fetches the page image stored under key. It returns ErrImageTooLarge
+// without reading the body when the object exceeds the ceiling.
+func (l *PageImageLoader) Load(ctx context.Context, key string) (*PageImage, error) {
+	result, err := l.s3.GetObject(ctx, &s3.GetObjectInput{
+		Key:    &key,
+		Bucket: aws.String(storage.BUCKET_NAME),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	if size := aws.ToInt64(result.ContentLength); size > l.maxBytes {
+		return nil, fmt.Errorf("%w: %d bytes, limit is %d", ErrImageTooLarge, size, l.maxBytes)
+	}
+
+	buf := l.buffers.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	hash := sha256.New()
+	n, err := buf.ReadFrom(io.TeeReader(io.LimitReader(result.Body, l.maxBytes+1), hash))
+	if err != nil {
+		l.putBuffer(buf)
+		return nil, err
+	}
+	if n > l.maxBytes {
+		l.putBuffer(buf)
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrImageTooLarge, l.maxBytes)
+	}
+
+	page := &PageImage{
+		Data:     buf.Bytes(),
+		MimeType: "image/png",
+		Hash:     fmt.Sprintf("%x", hash.Sum(nil)),
+		release:  func() { l.putBuffer(buf) },
+	}
+
+	if err := l.shrink(page); err != nil {
+		page.Release()
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// Hash returns the hex SHA-256 of the page image stored under key, the
+// content its OCR responses are cached under. Like Load it refuses images
+// above the ceiling, but streams them instead of buffering.
+func (l *PageImageLoader) Hash(ctx context.Context, key string) (string, error) {
+	result, err := l.s3.GetObject(ctx, &s3.GetObjectInput{
+		Key:    &key,
+		Bucket: aws.String(storage.BUCKET_NAME),
+	})
+	if err != nil {
+		return "", err
+	}
+	defer result.Body.Close()
+
+	if size := aws.ToInt64(result.ContentLength); size > l.maxBytes {
+		return "", fmt.Errorf("%w: %d bytes, limit is %d", ErrImageTooLarge, size, l.maxBytes)
+	}
+
+	hash := sha256.New()
+	n, err := io.Copy(hash, io.LimitReader(result.Body, l.maxBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if n > l.maxBytes {
+		return "", fmt.Errorf("%w: more than %d bytes", ErrImageTooLarge, l.maxBytes)
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// shrink downscales images larger than the maximum dimension and recompresses
+// images still above the target size as JPEG.
+func (l *PageImageLoader) shrink(page *PageImage) error {
+	cfg, err := png.DecodeConfig(bytes.NewReader(page.Data))
+	if err != nil {
+		return fmt.Errorf("error decoding page image: %w", err)
+	}
+
+	if pixels := int64(cfg.Width) * int64(cfg.Height); pixels > l.maxPixels {
+		return fmt.Errorf("%w: %dx%d pixels, limit is %d", ErrImageTooLarge, cfg.Width, cfg.Height, l.maxPixels)
+	}
+
+	oversized := max(cfg.Width, cfg.Height) > l.maxDimension
+	if !oversized && int64(len(page.Data)) <= l.targetBytes {
+		return nil
+	}
+
+	img, err := png.Decode(bytes.NewReader(page.Data))
+	if err != nil {
+		return fmt.Errorf("error decoding page image: %w", err)
+	}
+
+	if oversized {
+		img = imaging.Downscale(img, l.maxDimension)
+	}
+
+	buf := l.buffers.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	mimeType := "image/png"
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(buf, img); err != nil {
+		l.putBuffer(buf)
+		return err
+	}
+
+	if int64(buf.Len()) > l.targetBytes {
+		buf.Reset()
+		mimeType = "image/jpeg"
+		if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: recompressQuality}); err != nil {
+			l.putBuffer(buf)
+			return err
+		}
+	}
+
+	page.Release()
+	page.Data = buf.Bytes()
+	page.MimeType = mimeType
+	page.release = func() { l.putBuffer(buf) }
+
+	return nil
+}
+
+// putBuffer returns buf to the pool. Buffers grown past the target size are
+// left to the garbage collector so a few large images do not stay pinned.
+func (l *PageImageLoader) putBuffer(buf *bytes.Buffer) {
+	if int64(buf.Cap()) > l.targetBytes {
+		return
+	}
+	l.buffers.Put(buf)
+}
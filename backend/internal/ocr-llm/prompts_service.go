@@ -0,0 +1,109 @@
+package ocrllm
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/infrastructure/llm"
+	"backend/internal/infrastructure/service"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type PromptsService struct {
+	ocr.UnimplementedPromptsServiceServer
+	prompts *llm.PromptRegistry
+}
+
+var _ ocr.PromptsServiceServer = (*PromptsService)(nil)
+var _ service.Service = (*PromptsService)(nil)
+
+func NewPromptsService(
+	prompts *llm.PromptRegistry,
+) *PromptsService {
+	return &PromptsService{
+		prompts: prompts,
+	}
+}
+
+// ListPromptVersions implements ocr.PromptsServiceServer.
+func (s *PromptsService) ListPromptVersions(
+	ctx context.Context,
+	req *ocr.ListPromptVersionsRequest,
+) (*ocr.ListPromptVersionsResponse, error) {
+	versions, err := s.prompts.List(req.Agent)
+	if err != nil {
+		return nil, promptError(err)
+	}
+
+	active := s.prompts.Active(req.Agent)
+
+	result := make([]*ocr.PromptVersion, len(versions))
+	for i, version := range versions {
+		result[i] = promptVersionToProto(version, active)
+	}
+
+	return &ocr.ListPromptVersionsResponse{
+		Versions: result,
+	}, nil
+}
+
+// CreatePromptVersion implements ocr.PromptsServiceServer.
+func (s *PromptsService) CreatePromptVersion(
+	ctx context.Context,
+	req *ocr.CreatePromptVersionRequest,
+) (*ocr.PromptVersion, error) {
+	if req.System == "" || req.User == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "system and user prompts are required")
+	}
+
+	version, err := s.prompts.Create(ctx, req.Agent, req.Description, req.System, req.User)
+	if err != nil {
+		return nil, promptError(err)
+	}
+
+	return promptVersionToProto(version, s.prompts.Active(req.Agent)), nil
+}
+
+// ActivatePromptVersion implements ocr.PromptsServiceServer.
+func (s *PromptsService) ActivatePromptVersion(
+	ctx context.Context,
+	req *ocr.ActivatePromptVersionRequest,
+) (*emptypb.Empty, error) {
+	if err := s.prompts.Activate(ctx, req.Agent, req.Id); err != nil {
+		return nil, promptError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Register implements service.Service.
+func (s *PromptsService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	ocr.RegisterPromptsServiceHandlerServer(ctx, mux, s)
+}
+
+func promptError(err error) error {
+	switch {
+	case errors.Is(err, llm.ErrUnknownAgent):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, llm.ErrPromptVersionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func promptVersionToProto(version *llm.PromptVersion, active string) *ocr.PromptVersion {
+	return &ocr.PromptVersion{
+		Id:          version.Id,
+		Agent:       version.Agent,
+		Description: version.Description,
+		System:      version.System,
+		User:        version.User,
+		CreatedAt:   version.CreatedAt.Format(time.RFC3339),
+		Active:      version.Id == active,
+	}
+}
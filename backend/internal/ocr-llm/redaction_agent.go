@@ -0,0 +1,139 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/shared"
+)
+
+// DetectedText is a piece of personal data found by the LLM detector, quoted
+// verbatim from the page text.
+type DetectedText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type RedactionAgent struct {
+	cfg     *llm.RedactConfig
+	api     *openai.Client
+	cache   *llm.Cache
+	usage   *llm.UsageMeter
+	limiter *llm.Limiter
+	prompts *llm.PromptRegistry
+}
+
+func NewRedactionAgent(
+	cfg *llm.LlmConfig,
+	api *openai.Client,
+	cache *llm.Cache,
+	usage *llm.UsageMeter,
+	limiter *llm.Limiter,
+	prompts *llm.PromptRegistry,
+) *RedactionAgent {
+	return &RedactionAgent{
+		cfg:     &cfg.Redact,
+		api:     api,
+		cache:   cache,
+		usage:   usage,
+		limiter: limiter,
+		prompts: prompts,
+	}
+}
+
+func (a *RedactionAgent) Invoke(
+	ctx context.Context,
+	text string,
+) ([]DetectedText, *llm.Usage, error) {
+	prompt := a.prompts.Prompt("redact", &a.cfg.AgentConfig)
+
+	messages := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(prompt.System),
+		openai.UserMessage(fmt.Sprintf(
+			"%s\nEntity types: %s\n\nDocument page:\n%s",
+			prompt.User,
+			strings.Join(a.cfg.Types, ", "),
+			text,
+		)),
+	}
+
+	cacheKey := llm.CacheKey{
+		Agent:         "redact",
+		Chain:         a.cfg.ChainKey(),
+		PromptVersion: prompt.Tag(),
+		ContentHash:   llm.ContentHash(fmt.Appendf(nil, "%s\x00%s", strings.Join(a.cfg.Types, ","), text)),
+	}
+
+	var usage *llm.Usage
+	response, ok := a.cache.Get(ctx, cacheKey)
+	if !ok {
+		params := openai.ChatCompletionNewParams{
+			Messages: messages,
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+					JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:   "personal_data",
+						Strict: openai.Bool(true),
+						Schema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"entities": map[string]any{
+									"type": "array",
+									"items": map[string]any{
+										"type": "object",
+										"properties": map[string]any{
+											"type": map[string]any{
+												"type": "string",
+												"enum": a.cfg.Types,
+											},
+											"text": map[string]any{
+												"type": "string",
+											},
+										},
+										"required":             []string{"type", "text"},
+										"additionalProperties": false,
+									},
+								},
+							},
+							"required":             []string{"entities"},
+							"additionalProperties": false,
+						},
+					},
+				},
+			},
+		}
+
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		var target *llm.TargetConfig
+		var err error
+		response, target, err = llm.Complete(ctx, a.api, &a.cfg.AgentConfig, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		usage = a.usage.Record(ctx, "redact", target, response)
+		recordLimits(ctx, a.limiter, usage)
+
+		if err := a.cache.Put(ctx, cacheKey, response); err != nil {
+			slog.ErrorContext(ctx, "Failed to cache llm response", "agent", cacheKey.Agent, "error", err)
+		}
+	}
+
+	var result struct {
+		Entities []DetectedText `json:"entities"`
+	}
+	if len(response.Choices) > 0 {
+		if err := json.Unmarshal([]byte(response.Choices[0].Message.Content), &result); err != nil {
+			return nil, usage, fmt.Errorf("error decoding detected entities: %w", err)
+		}
+	}
+
+	return result.Entities, usage, nil
+}
@@ -0,0 +1,226 @@
+package ocrllm
+
+import (
+	ocrpb "backend/gen/ocr"
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/llm"
+	"cmp"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const llmDetector = "llm"
+
+// Box is a region of a page image in coordinates relative to the image size,
+// so that boxes stay valid for downscaled copies.
+type Box struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Entity is a span of personal data in the OCR text. Start and End are byte
+// offsets into the text. Boxes cover the entity on the page image and are
+// empty when the page has no layout.
+type Entity struct {
+	Type     string
+	Detector string
+	Start    int
+	End      int
+	Boxes    []Box
+}
+
+type detector struct {
+	entityType string
+	pattern    *regexp.Regexp
+}
+
+// Redactor finds personal data in page text with the configured regex
+// detectors and, optionally, the LLM redaction agent.
+type Redactor struct {
+	enabled   bool
+	useLlm    bool
+	detectors []detector
+	agent     *RedactionAgent
+}
+
+func NewRedactor(
+	cfg *config.AppConfig,
+	agent *RedactionAgent,
+) (*Redactor, error) {
+	redactor := &Redactor{
+		enabled: cfg.Redaction.Enabled,
+		useLlm:  cfg.Redaction.Llm,
+		agent:   agent,
+	}
+
+	for _, d := range cfg.Redaction.Detectors {
+		pattern, err := regexp.Compile(d.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling %s detector: %w", d.Type, err)
+		}
+		redactor.detectors = append(redactor.detectors, detector{
+			entityType: d.Type,
+			pattern:    pattern,
+		})
+	}
+
+	return redactor, nil
+}
+
+func (r *Redactor) Enabled() bool {
+	return r.enabled
+}
+
+// Detect returns the entities found in text ordered by offset.
+func (r *Redactor) Detect(ctx context.Context, text string) ([]Entity, *llm.Usage, error) {
+	var entities []Entity
+
+	for _, d := range r.detectors {
+		for _, match := range d.pattern.FindAllStringIndex(text, -1) {
+			entities = append(entities, Entity{
+				Type:     d.entityType,
+				Detector: "regex",
+				Start:    match[0],
+				End:      match[1],
+			})
+		}
+	}
+
+	var usage *llm.Usage
+	if r.useLlm {
+		detected, u, err := r.agent.Invoke(ctx, text)
+		if err != nil {
+			return nil, u, err
+		}
+		usage = u
+
+		// The agent quotes entities, every occurrence in the text is redacted
+		for _, d := range detected {
+			if d.Text == "" {
+				continue
+			}
+			for offset := 0; ; {
+				i := strings.Index(text[offset:], d.Text)
+				if i < 0 {
+					break
+				}
+				start := offset + i
+				entities = append(entities, Entity{
+					Type:     d.Type,
+					Detector: llmDetector,
+					Start:    start,
+					End:      start + len(d.Text),
+				})
+				offset = start + len(d.Text)
+			}
+		}
+	}
+
+	slices.SortFunc(entities, func(a, b Entity) int {
+		return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(b.End, a.End))
+	})
+
+	return entities, usage, nil
+}
+
+// RedactText replaces every entity with its type in brackets, e.g. [EMAIL].
+// Overlapping entities are redacted as one using the type of the first.
+func RedactText(text string, entities []Entity) string {
+	var b strings.Builder
+	b.Grow(len(text))
+
+	pos := 0
+	for _, entity := range entities {
+		if entity.End <= pos {
+			continue
+		}
+		if entity.Start >= pos {
+			b.WriteString(text[pos:entity.Start])
+			b.WriteString("[" + strings.ToUpper(entity.Type) + "]")
+		}
+		pos = entity.End
+	}
+	b.WriteString(text[pos:])
+
+	return b.String()
+}
+
+// RedactImage returns a copy of img with black boxes drawn over boxes.
+func RedactImage(img image.Image, boxes []Box) image.Image {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(bounds)
+	draw.Draw(dst, bounds, img, bounds.Min, draw.Src)
+
+	w, h := float64(bounds.Dx()), float64(bounds.Dy())
+	for _, box := range boxes {
+		rect := image.Rect(
+			bounds.Min.X+int(box.X*w),
+			bounds.Min.Y+int(box.Y*h),
+			bounds.Min.X+int((box.X+box.Width)*w+1),
+			bounds.Min.Y+int((box.Y+box.Height)*h+1),
+		).Intersect(bounds)
+		draw.Draw(dst, rect, image.NewUniform(color.Black), image.Point{}, draw.Src)
+	}
+
+	return dst
+}
+
+// LocateEntities sets the boxes of entities from the words of the page
+// layout. The OCR text and the layout come from different engines, so words
+// are matched by their text and every occurrence of an entity is covered.
+func LocateEntities(text string, entities []Entity, layout *ocrpb.PageLayout) {
+	if layout == nil || layout.Width == 0 || layout.Height == 0 {
+		return
+	}
+
+	var words []*ocrpb.LayoutWord
+	var tokens []string
+	for _, line := range layout.Lines {
+		for _, word := range line.Words {
+			words = append(words, word)
+			tokens = append(tokens, normalizeToken(word.Text))
+		}
+	}
+
+	w, h := float64(layout.Width), float64(layout.Height)
+	for i := range entities {
+		var needle []string
+		for _, field := range strings.Fields(text[entities[i].Start:entities[i].End]) {
+			if token := normalizeToken(field); token != "" {
+				needle = append(needle, token)
+			}
+		}
+		if len(needle) == 0 {
+			continue
+		}
+
+		for start := 0; start+len(needle) <= len(tokens); start++ {
+			if !slices.Equal(tokens[start:start+len(needle)], needle) {
+				continue
+			}
+			for _, word := range words[start : start+len(needle)] {
+				entities[i].Boxes = append(entities[i].Boxes, Box{
+					X:      float64(word.Box.GetX()) / w,
+					Y:      float64(word.Box.GetY()) / h,
+					Width:  float64(word.Box.GetWidth()) / w,
+					Height: float64(word.Box.GetHeight()) / h,
+				})
+			}
+		}
+	}
+}
+
+func normalizeToken(s string) string {
+	return strings.ToLower(strings.TrimFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
@@ -0,0 +1,80 @@
+package ocrllm
+
+import "testing"
+
+func TestRedactText(t *testing.T) {
+	text := "Mail jane@example.com or call +1 555 0100."
+
+	tests := []struct {
+		name     string
+		text     string
+		entities []Entity
+		want     string
+	}{
+		{
+			name: "no entities",
+			text: text,
+			want: text,
+		},
+		{
+			name: "single entity",
+			text: text,
+			entities: []Entity{
+				{Type: "email", Start: 5, End: 21},
+			},
+			want: "Mail [EMAIL] or call +1 555 0100.",
+		},
+		{
+			name: "several entities",
+			text: text,
+			entities: []Entity{
+				{Type: "email", Start: 5, End: 21},
+				{Type: "phone", Start: 30, End: 41},
+			},
+			want: "Mail [EMAIL] or call [PHONE].",
+		},
+		{
+			name: "overlapping entities use the first type",
+			text: text,
+			entities: []Entity{
+				{Type: "email", Start: 5, End: 21},
+				{Type: "person", Start: 5, End: 9},
+				{Type: "domain", Start: 10, End: 24},
+			},
+			want: "Mail [EMAIL] call +1 555 0100.",
+		},
+		{
+			name: "nested entity is skipped",
+			text: text,
+			entities: []Entity{
+				{Type: "email", Start: 5, End: 21},
+				{Type: "domain", Start: 10, End: 21},
+			},
+			want: "Mail [EMAIL] or call +1 555 0100.",
+		},
+		{
+			name: "whole text",
+			text: "secret",
+			entities: []Entity{
+				{Type: "password", Start: 0, End: 6},
+			},
+			want: "[PASSWORD]",
+		},
+		{
+			name: "byte offsets in multibyte text",
+			text: "Name: Zoë Ørsted!",
+			entities: []Entity{
+				{Type: "person", Start: 6, End: 18},
+			},
+			want: "Name: [PERSON]!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactText(tt.text, tt.entities); got != tt.want {
+				t.Errorf("RedactText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/shared"
+)
+
+const defaultChunkPages = 8
+
+type Summary struct {
+	Title   string `json:"title"`
+	Summary string `json:"summary"`
+	// Model is the routed target, also for cached responses
+	Model string `json:"-"`
+}
+
+type SummaryResult struct {
+	*Summary
+	// Usage holds one entry per LLM call, calls served from the cache are
+	// left out
+	Usage         []*llm.Usage
+	PromptVersion string
+}
+
+type SummaryAgent struct {
+	cfg     *llm.SummarizeConfig
+	api     *openai.Client
+	cache   *llm.Cache
+	usage   *llm.UsageMeter
+	limiter *llm.Limiter
+	prompts *llm.PromptRegistry
+}
+
+func NewSummaryAgent(
+	cfg *llm.LlmConfig,
+	api *openai.Client,
+	cache *llm.Cache,
+	usage *llm.UsageMeter,
+	limiter *llm.Limiter,
+	prompts *llm.PromptRegistry,
+) *SummaryAgent {
+	return &SummaryAgent{
+		cfg:     &cfg.Summarize,
+		api:     api,
+		cache:   cache,
+		usage:   usage,
+		limiter: limiter,
+		prompts: prompts,
+	}
+}
+
+// MinPages returns the page count from which documents are summarised.
+func (a *SummaryAgent) MinPages() int {
+	return max(a.cfg.MinPages, 1)
+}
+
+// Invoke summarises a document. Documents longer than the configured chunk
+// size are summarised chunk by chunk first and the partial summaries are then
+// combined into the final one.
+func (a *SummaryAgent) Invoke(
+	ctx context.Context,
+	pages []string,
+) (*SummaryResult, error) {
+	prompt := a.prompts.Prompt("summarize", &a.cfg.AgentConfig)
+
+	result := &SummaryResult{
+		PromptVersion: prompt.Id,
+	}
+
+	chunkPages := a.cfg.ChunkPages
+	if chunkPages <= 0 {
+		chunkPages = defaultChunkPages
+	}
+
+	if len(pages) <= chunkPages {
+		summary, usage, err := a.complete(ctx, prompt, "Document", strings.Join(pages, "\n\n"))
+		if err != nil {
+			return nil, err
+		}
+		result.Summary = summary
+		result.addUsage(usage)
+		return result, nil
+	}
+
+	// Map: summarise consecutive chunks of pages
+	partials := make([]string, 0, (len(pages)+chunkPages-1)/chunkPages)
+	for start := 0; start < len(pages); start += chunkPages {
+		end := min(start+chunkPages, len(pages))
+
+		summary, usage, err := a.complete(
+			ctx,
+			prompt,
+			fmt.Sprintf("Pages %d-%d of a longer document", start+1, end),
+			strings.Join(pages[start:end], "\n\n"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.addUsage(usage)
+
+		partials = append(partials, fmt.Sprintf("Pages %d-%d:\n%s", start+1, end, summary.Summary))
+	}
+
+	// Reduce: combine the partial summaries
+	summary, usage, err := a.complete(
+		ctx,
+		prompt,
+		"Summaries of consecutive sections of a document",
+		strings.Join(partials, "\n\n"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.Summary = summary
+	result.addUsage(usage)
+
+	return result, nil
+}
+
+func (r *SummaryResult) addUsage(usage *llm.Usage) {
+	if usage != nil {
+		r.Usage = append(r.Usage, usage)
+	}
+}
+
+func (a *SummaryAgent) complete(
+	ctx context.Context,
+	prompt *llm.PromptVersion,
+	label string,
+	text string,
+) (*Summary, *llm.Usage, error) {
+	messages := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(prompt.System),
+		openai.UserMessage(fmt.Sprintf(
+			"%s\n\n%s:\n%s",
+			prompt.User,
+			label,
+			text,
+		)),
+	}
+
+	cacheKey := llm.CacheKey{
+		Agent:         "summarize",
+		Chain:         a.cfg.ChainKey(),
+		PromptVersion: prompt.Tag(),
+		ContentHash:   llm.ContentHash(fmt.Appendf(nil, "%s\x00%s", label, text)),
+	}
+
+	var usage *llm.Usage
+	var target *llm.TargetConfig
+	response, ok := a.cache.Get(ctx, cacheKey)
+	if ok {
+		target = llm.ServedBy(&a.cfg.AgentConfig, response)
+	} else {
+		params := openai.ChatCompletionNewParams{
+			Messages: messages,
+			ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+				OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+					JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+						Name:   "document_summary",
+						Strict: openai.Bool(true),
+						Schema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"title": map[string]any{
+									"type":        "string",
+									"description": "Short descriptive title of the document",
+								},
+								"summary": map[string]any{
+									"type": "string",
+								},
+							},
+							"required":             []string{"title", "summary"},
+							"additionalProperties": false,
+						},
+					},
+				},
+			},
+		}
+
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		var err error
+		response, target, err = llm.Complete(ctx, a.api, &a.cfg.AgentConfig, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		usage = a.usage.Record(ctx, "summarize", target, response)
+		recordLimits(ctx, a.limiter, usage)
+
+		if err := a.cache.Put(ctx, cacheKey, response); err != nil {
+			slog.ErrorContext(ctx, "Failed to cache llm response", "agent", cacheKey.Agent, "error", err)
+		}
+	}
+
+	summary := &Summary{
+		Model: target.Model,
+	}
+	if len(response.Choices) > 0 {
+		if err := json.Unmarshal([]byte(response.Choices[0].Message.Content), summary); err != nil {
+			return nil, usage, fmt.Errorf("error decoding summary: %w", err)
+		}
+	}
+
+	return summary, usage, nil
+}
@@ -0,0 +1,116 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/openai/openai-go/v3"
+)
+
+type TranslationResult struct {
+	Text string
+	// Usage is nil when the response was served from the cache
+	Usage *llm.Usage
+	// Model and Provider are the routed target, also for cached responses
+	Model         string
+	Provider      string
+	PromptVersion string
+}
+
+type TranslationAgent struct {
+	cfg     *llm.TranslateConfig
+	api     *openai.Client
+	cache   *llm.Cache
+	usage   *llm.UsageMeter
+	limiter *llm.Limiter
+	prompts *llm.PromptRegistry
+}
+
+func NewTranslationAgent(
+	cfg *llm.LlmConfig,
+	api *openai.Client,
+	cache *llm.Cache,
+	usage *llm.UsageMeter,
+	limiter *llm.Limiter,
+	prompts *llm.PromptRegistry,
+) *TranslationAgent {
+	return &TranslationAgent{
+		cfg:     &cfg.Translate,
+		api:     api,
+		cache:   cache,
+		usage:   usage,
+		limiter: limiter,
+		prompts: prompts,
+	}
+}
+
+// Languages returns the target languages pages are translated into.
+func (a *TranslationAgent) Languages() []string {
+	return a.cfg.Languages
+}
+
+func (a *TranslationAgent) Invoke(
+	ctx context.Context,
+	language string,
+	text string,
+) (*TranslationResult, error) {
+	prompt := a.prompts.Prompt("translate", &a.cfg.AgentConfig)
+
+	messages := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(prompt.System),
+		openai.UserMessage(fmt.Sprintf(
+			"%s\nTarget language: %s\n\nDocument page:\n%s",
+			prompt.User,
+			language,
+			text,
+		)),
+	}
+
+	cacheKey := llm.CacheKey{
+		Agent:         "translate",
+		Chain:         a.cfg.ChainKey(),
+		PromptVersion: prompt.Tag(),
+		ContentHash:   llm.ContentHash(fmt.Appendf(nil, "%s\x00%s", language, text)),
+	}
+
+	var usage *llm.Usage
+	var target *llm.TargetConfig
+	response, ok := a.cache.Get(ctx, cacheKey)
+	if ok {
+		target = llm.ServedBy(&a.cfg.AgentConfig, response)
+	} else {
+		params := openai.ChatCompletionNewParams{
+			Messages: messages,
+		}
+
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		var err error
+		response, target, err = llm.Complete(ctx, a.api, &a.cfg.AgentConfig, params)
+		if err != nil {
+			return nil, err
+		}
+		usage = a.usage.Record(ctx, "translate", target, response)
+		recordLimits(ctx, a.limiter, usage)
+
+		if err := a.cache.Put(ctx, cacheKey, response); err != nil {
+			slog.ErrorContext(ctx, "Failed to cache llm response", "agent", cacheKey.Agent, "error", err)
+		}
+	}
+
+	result := &TranslationResult{
+		Usage:         usage,
+		Model:         target.Model,
+		Provider:      llm.Provider(target.Providers, response),
+		PromptVersion: prompt.Id,
+	}
+	if len(response.Choices) > 0 {
+		result.Text = response.Choices[0].Message.Content
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package ocrllm
+
+import (
+	"backend/internal/infrastructure/llm"
+	ocrdb "backend/internal/ocr/db"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// saveUsage stores the usage of an LLM call against a file and optionally one
+// of its pages. Cached responses have no usage and are not stored.
+func saveUsage(
+	ctx context.Context,
+	db *ocrdb.Queries,
+	fileId ulid.ULID,
+	pageId *ulid.ULID,
+	usage *llm.Usage,
+) error {
+	if usage == nil {
+		return nil
+	}
+
+	id := ulid.MustNew(
+		ulid.Timestamp(time.Now()),
+		ulid.DefaultEntropy(),
+	)
+
+	params := ocrdb.CreateLlmUsageParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		Agent:            usage.Agent,
+		Model:            usage.Model,
+		Provider:         usage.Provider,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		Cost:             usage.Cost,
+	}
+	if pageId != nil {
+		params.PageID = pgtype.UUID{
+			Bytes: *pageId,
+			Valid: true,
+		}
+	}
+
+	return db.CreateLlmUsage(ctx, params)
+}
+
+// recordLimits adds the usage of an LLM call to the rate and budget counters.
+// Failures are logged rather than returned, the response is already paid for
+// and a redelivery would call the LLM again.
+func recordLimits(ctx context.Context, limiter *llm.Limiter, usage *llm.Usage) {
+	if err := limiter.Record(ctx, usage); err != nil {
+		trace.SpanFromContext(ctx).RecordError(err)
+		slog.ErrorContext(ctx, "Failed to record llm usage limits", "agent", usage.Agent, "error", err)
+	}
+}
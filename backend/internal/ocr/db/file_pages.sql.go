@@ -11,9 +11,39 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countFilePagesByFileID = `-- name: CountFilePagesByFileID :one
+SELECT COUNT(*) FROM ocr.file_pages
+WHERE file_id = $1 AND tenant_id = $2
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND ($3::int IS NULL OR page_number >= $3)
+  AND ($4::int IS NULL OR page_number <= $4)
+`
+
+type CountFilePagesByFileIDParams struct {
+	FileID   pgtype.UUID `json:"file_id"`
+	TenantID string      `json:"tenant_id"`
+	FromPage *int32      `json:"from_page"`
+	ToPage   *int32      `json:"to_page"`
+}
+
+func (q *Queries) CountFilePagesByFileID(ctx context.Context, arg CountFilePagesByFileIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFilePagesByFileID,
+		arg.FileID,
+		arg.TenantID,
+		arg.FromPage,
+		arg.ToPage,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createFilePage = `-- name: CreateFilePage :exec
-INSERT INTO ocr.file_pages (id, file_id, page_image_key, page_number)
-VALUES ($1, $2, $3, $4)
+INSERT INTO ocr.file_pages (id, file_id, page_image_key, page_number, content_hash, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateFilePageParams struct {
@@ -21,6 +51,8 @@ type CreateFilePageParams struct {
 	FileID       pgtype.UUID `json:"file_id"`
 	PageImageKey string      `json:"page_image_key"`
 	PageNumber   int32       `json:"page_number"`
+	ContentHash  *string     `json:"content_hash"`
+	TenantID     string      `json:"tenant_id"`
 }
 
 func (q *Queries) CreateFilePage(ctx context.Context, arg CreateFilePageParams) error {
@@ -29,6 +61,8 @@ func (q *Queries) CreateFilePage(ctx context.Context, arg CreateFilePageParams)
 		arg.FileID,
 		arg.PageImageKey,
 		arg.PageNumber,
+		arg.ContentHash,
+		arg.TenantID,
 	)
 	return err
 }
@@ -43,6 +77,71 @@ func (q *Queries) DeleteFilePagesByFileID(ctx context.Context, fileID pgtype.UUI
 	return err
 }
 
+const fileBelongsToTenant = `-- name: FileBelongsToTenant :one
+SELECT EXISTS (
+    SELECT 1 FROM ocr.file_pages p
+    WHERE p.file_id = $1 AND p.tenant_id = $2
+      AND NOT EXISTS (
+          SELECT 1 FROM ocr.file_states s
+          WHERE s.file_id = p.file_id AND s.trashed
+      )
+)
+`
+
+type FileBelongsToTenantParams struct {
+	FileID   pgtype.UUID `json:"file_id"`
+	TenantID string      `json:"tenant_id"`
+}
+
+func (q *Queries) FileBelongsToTenant(ctx context.Context, arg FileBelongsToTenantParams) (bool, error) {
+	row := q.db.QueryRow(ctx, fileBelongsToTenant, arg.FileID, arg.TenantID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const getDuplicateFilePage = `-- name: GetDuplicateFilePage :one
+SELECT id, text_content, ocr_model, ocr_provider, prompt_version
+FROM ocr.file_pages
+WHERE tenant_id = $1
+  AND file_id <> $2
+  AND text_content IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND content_hash = $3
+ORDER BY created_at ASC
+LIMIT 1
+`
+
+type GetDuplicateFilePageParams struct {
+	TenantID    string      `json:"tenant_id"`
+	FileID      pgtype.UUID `json:"file_id"`
+	ContentHash *string     `json:"content_hash"`
+}
+
+type GetDuplicateFilePageRow struct {
+	ID            pgtype.UUID `json:"id"`
+	TextContent   *string     `json:"text_content"`
+	OcrModel      *string     `json:"ocr_model"`
+	OcrProvider   *string     `json:"ocr_provider"`
+	PromptVersion *string     `json:"prompt_version"`
+}
+
+func (q *Queries) GetDuplicateFilePage(ctx context.Context, arg GetDuplicateFilePageParams) (GetDuplicateFilePageRow, error) {
+	row := q.db.QueryRow(ctx, getDuplicateFilePage, arg.TenantID, arg.FileID, arg.ContentHash)
+	var i GetDuplicateFilePageRow
+	err := row.Scan(
+		&i.ID,
+		&i.TextContent,
+		&i.OcrModel,
+		&i.OcrProvider,
+		&i.PromptVersion,
+	)
+	return i, err
+}
+
 const getFilePageContentByID = `-- name: GetFilePageContentByID :one
 SELECT text_content
 FROM ocr.file_pages
@@ -57,42 +156,112 @@ func (q *Queries) GetFilePageContentByID(ctx context.Context, id pgtype.UUID) (*
 }
 
 const getFilePagesByFileID = `-- name: GetFilePagesByFileID :many
-SELECT 
-    id, file_id, page_image_key, page_number, text_content, error_message, created_at, updated_at,
-    COUNT(*) OVER() AS total
-FROM ocr.file_pages
-WHERE file_id = $1
+SELECT id, file_id, page_image_key, page_number, text_content, error_message, created_at, updated_at, ocr_model, ocr_provider, prompt_version, content_hash, tenant_id FROM ocr.file_pages
+WHERE file_id = $1 AND tenant_id = $2
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND ($3::int IS NULL OR page_number >= $3)
+  AND ($4::int IS NULL OR page_number <= $4)
+  AND ($5::int IS NULL OR page_number > $5)
 ORDER BY page_number ASC
-LIMIT $2 OFFSET $3
+LIMIT $6 OFFSET $7
 `
 
 type GetFilePagesByFileIDParams struct {
-	FileID pgtype.UUID `json:"file_id"`
-	Limit  int32       `json:"limit"`
-	Offset int32       `json:"offset"`
+	FileID    pgtype.UUID `json:"file_id"`
+	TenantID  string      `json:"tenant_id"`
+	FromPage  *int32      `json:"from_page"`
+	ToPage    *int32      `json:"to_page"`
+	AfterPage *int32      `json:"after_page"`
+	Limit     int32       `json:"limit"`
+	Offset    int32       `json:"offset"`
 }
 
-type GetFilePagesByFileIDRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	FileID       pgtype.UUID        `json:"file_id"`
-	PageImageKey string             `json:"page_image_key"`
-	PageNumber   int32              `json:"page_number"`
-	TextContent  *string            `json:"text_content"`
-	ErrorMessage *string            `json:"error_message"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	Total        int64              `json:"total"`
+func (q *Queries) GetFilePagesByFileID(ctx context.Context, arg GetFilePagesByFileIDParams) ([]OcrFilePage, error) {
+	rows, err := q.db.Query(ctx, getFilePagesByFileID,
+		arg.FileID,
+		arg.TenantID,
+		arg.FromPage,
+		arg.ToPage,
+		arg.AfterPage,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OcrFilePage
+	for rows.Next() {
+		var i OcrFilePage
+		if err := rows.Scan(
+			&i.ID,
+			&i.FileID,
+			&i.PageImageKey,
+			&i.PageNumber,
+			&i.TextContent,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OcrModel,
+			&i.OcrProvider,
+			&i.PromptVersion,
+			&i.ContentHash,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFilePagesByFileIDDesc = `-- name: GetFilePagesByFileIDDesc :many
+SELECT id, file_id, page_image_key, page_number, text_content, error_message, created_at, updated_at, ocr_model, ocr_provider, prompt_version, content_hash, tenant_id FROM ocr.file_pages
+WHERE file_id = $1 AND tenant_id = $2
+  AND NOT EXISTS (
+      SELECT 1 FROM ocr.file_states s
+      WHERE s.file_id = file_pages.file_id AND s.trashed
+  )
+  AND ($3::int IS NULL OR page_number >= $3)
+  AND ($4::int IS NULL OR page_number <= $4)
+  AND ($5::int IS NULL OR page_number < $5)
+ORDER BY page_number DESC
+LIMIT $6 OFFSET $7
+`
+
+type GetFilePagesByFileIDDescParams struct {
+	FileID    pgtype.UUID `json:"file_id"`
+	TenantID  string      `json:"tenant_id"`
+	FromPage  *int32      `json:"from_page"`
+	ToPage    *int32      `json:"to_page"`
+	AfterPage *int32      `json:"after_page"`
+	Limit     int32       `json:"limit"`
+	Offset    int32       `json:"offset"`
 }
 
-func (q *Queries) GetFilePagesByFileID(ctx context.Context, arg GetFilePagesByFileIDParams) ([]GetFilePagesByFileIDRow, error) {
-	rows, err := q.db.Query(ctx, getFilePagesByFileID, arg.FileID, arg.Limit, arg.Offset)
+func (q *Queries) GetFilePagesByFileIDDesc(ctx context.Context, arg GetFilePagesByFileIDDescParams) ([]OcrFilePage, error) {
+	rows, err := q.db.Query(ctx, getFilePagesByFileIDDesc,
+		arg.FileID,
+		arg.TenantID,
+		arg.FromPage,
+		arg.ToPage,
+		arg.AfterPage,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetFilePagesByFileIDRow
+	var items []OcrFilePage
 	for rows.Next() {
-		var i GetFilePagesByFileIDRow
+		var i OcrFilePage
 		if err := rows.Scan(
 			&i.ID,
 			&i.FileID,
@@ -102,7 +271,129 @@ func (q *Queries) GetFilePagesByFileID(ctx context.Context, arg GetFilePagesByFi
 			&i.ErrorMessage,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.Total,
+			&i.OcrModel,
+			&i.OcrProvider,
+			&i.PromptVersion,
+			&i.ContentHash,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFileProcessingStatus = `-- name: GetFileProcessingStatus :one
+SELECT
+    COALESCE((
+        SELECT s.page_count FROM ocr.file_states s
+        WHERE s.file_id = $1 AND s.tenant_id = $2
+    ), 0)::int AS page_count,
+    COUNT(*) FILTER (WHERE text_content IS NOT NULL) AS processed_pages,
+    COUNT(*) FILTER (WHERE error_message IS NOT NULL) AS failed_pages
+FROM ocr.file_pages
+WHERE file_id = $1 AND tenant_id = $2
+`
+
+type GetFileProcessingStatusParams struct {
+	FileID   pgtype.UUID `json:"file_id"`
+	TenantID string      `json:"tenant_id"`
+}
+
+type GetFileProcessingStatusRow struct {
+	PageCount      int32 `json:"page_count"`
+	ProcessedPages int64 `json:"processed_pages"`
+	FailedPages    int64 `json:"failed_pages"`
+}
+
+func (q *Queries) GetFileProcessingStatus(ctx context.Context, arg GetFileProcessingStatusParams) (GetFileProcessingStatusRow, error) {
+	row := q.db.QueryRow(ctx, getFileProcessingStatus, arg.FileID, arg.TenantID)
+	var i GetFileProcessingStatusRow
+	err := row.Scan(&i.PageCount, &i.ProcessedPages, &i.FailedPages)
+	return i, err
+}
+
+const getFirstFilePagesText = `-- name: GetFirstFilePagesText :many
+SELECT page_number, text_content, error_message
+FROM ocr.file_pages
+WHERE file_id = $1 AND page_number < $2
+ORDER BY page_number ASC
+`
+
+type GetFirstFilePagesTextParams struct {
+	FileID     pgtype.UUID `json:"file_id"`
+	PageNumber int32       `json:"page_number"`
+}
+
+type GetFirstFilePagesTextRow struct {
+	PageNumber   int32   `json:"page_number"`
+	TextContent  *string `json:"text_content"`
+	ErrorMessage *string `json:"error_message"`
+}
+
+func (q *Queries) GetFirstFilePagesText(ctx context.Context, arg GetFirstFilePagesTextParams) ([]GetFirstFilePagesTextRow, error) {
+	rows, err := q.db.Query(ctx, getFirstFilePagesText, arg.FileID, arg.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFirstFilePagesTextRow
+	for rows.Next() {
+		var i GetFirstFilePagesTextRow
+		if err := rows.Scan(&i.PageNumber, &i.TextContent, &i.ErrorMessage); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPageFiles = `-- name: GetPageFiles :many
+SELECT
+    file_id,
+    tenant_id,
+    COUNT(*) AS pages,
+    MAX(created_at)::timestamptz AS last_created_at
+FROM ocr.file_pages
+WHERE file_id > $1
+GROUP BY file_id, tenant_id
+ORDER BY file_id
+LIMIT $2
+`
+
+type GetPageFilesParams struct {
+	After pgtype.UUID `json:"after"`
+	Limit int32       `json:"limit"`
+}
+
+type GetPageFilesRow struct {
+	FileID        pgtype.UUID        `json:"file_id"`
+	TenantID      string             `json:"tenant_id"`
+	Pages         int64              `json:"pages"`
+	LastCreatedAt pgtype.Timestamptz `json:"last_created_at"`
+}
+
+func (q *Queries) GetPageFiles(ctx context.Context, arg GetPageFilesParams) ([]GetPageFilesRow, error) {
+	rows, err := q.db.Query(ctx, getPageFiles, arg.After, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPageFilesRow
+	for rows.Next() {
+		var i GetPageFilesRow
+		if err := rows.Scan(
+			&i.FileID,
+			&i.TenantID,
+			&i.Pages,
+			&i.LastCreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -114,18 +405,69 @@ func (q *Queries) GetFilePagesByFileID(ctx context.Context, arg GetFilePagesByFi
 	return items, nil
 }
 
+const pageBelongsToTenant = `-- name: PageBelongsToTenant :one
+SELECT EXISTS (
+    SELECT 1 FROM ocr.file_pages p
+    WHERE p.id = $1 AND p.tenant_id = $2
+      AND NOT EXISTS (
+          SELECT 1 FROM ocr.file_states s
+          WHERE s.file_id = p.file_id AND s.trashed
+      )
+)
+`
+
+type PageBelongsToTenantParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID string      `json:"tenant_id"`
+}
+
+func (q *Queries) PageBelongsToTenant(ctx context.Context, arg PageBelongsToTenantParams) (bool, error) {
+	row := q.db.QueryRow(ctx, pageBelongsToTenant, arg.ID, arg.TenantID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const updateFilePageError = `-- name: UpdateFilePageError :exec
+UPDATE ocr.file_pages
+SET error_message = $2
+WHERE id = $1
+`
+
+type UpdateFilePageErrorParams struct {
+	ID           pgtype.UUID `json:"id"`
+	ErrorMessage *string     `json:"error_message"`
+}
+
+func (q *Queries) UpdateFilePageError(ctx context.Context, arg UpdateFilePageErrorParams) error {
+	_, err := q.db.Exec(ctx, updateFilePageError, arg.ID, arg.ErrorMessage)
+	return err
+}
+
 const updateFilePageText = `-- name: UpdateFilePageText :exec
 UPDATE ocr.file_pages
-SET text_content = $2
+SET text_content = $2,
+    ocr_model = COALESCE($3, ocr_model),
+    ocr_provider = COALESCE($4, ocr_provider),
+    prompt_version = $5
 WHERE id = $1
 `
 
 type UpdateFilePageTextParams struct {
-	ID          pgtype.UUID `json:"id"`
-	TextContent *string     `json:"text_content"`
+	ID            pgtype.UUID `json:"id"`
+	TextContent   *string     `json:"text_content"`
+	OcrModel      *string     `json:"ocr_model"`
+	OcrProvider   *string     `json:"ocr_provider"`
+	PromptVersion *string     `json:"prompt_version"`
 }
 
 func (q *Queries) UpdateFilePageText(ctx context.Context, arg UpdateFilePageTextParams) error {
-	_, err := q.db.Exec(ctx, updateFilePageText, arg.ID, arg.TextContent)
+	_, err := q.db.Exec(ctx, updateFilePageText,
+		arg.ID,
+		arg.TextContent,
+		arg.OcrModel,
+		arg.OcrProvider,
+		arg.PromptVersion,
+	)
 	return err
 }
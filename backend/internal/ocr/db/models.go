@@ -8,15 +8,63 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type OcrExtractionSchema struct {
+	ID          pgtype.UUID        `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	JsonSchema  []byte             `json:"json_schema"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	TenantID    string             `json:"tenant_id"`
+}
+
 type OcrFilePage struct {
-	ID           pgtype.UUID        `json:"id"`
+	ID            pgtype.UUID        `json:"id"`
+	FileID        pgtype.UUID        `json:"file_id"`
+	PageImageKey  string             `json:"page_image_key"`
+	PageNumber    int32              `json:"page_number"`
+	TextContent   *string            `json:"text_content"`
+	ErrorMessage  *string            `json:"error_message"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	OcrModel      *string            `json:"ocr_model"`
+	OcrProvider   *string            `json:"ocr_provider"`
+	PromptVersion *string            `json:"prompt_version"`
+	ContentHash   *string            `json:"content_hash"`
+	TenantID      string             `json:"tenant_id"`
+}
+
+type OcrFileState struct {
 	FileID       pgtype.UUID        `json:"file_id"`
-	PageImageKey string             `json:"page_image_key"`
-	PageNumber   int32              `json:"page_number"`
-	TextContent  *string            `json:"text_content"`
-	ErrorMessage *string            `json:"error_message"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	TenantID     string             `json:"tenant_id"`
+	Trashed      bool               `json:"trashed"`
+	ChangedAt    pgtype.Timestamptz `json:"changed_at"`
+	PageCount    *int32             `json:"page_count"`
+	ClassifiedAt pgtype.Timestamptz `json:"classified_at"`
+}
+
+type OcrFileSummary struct {
+	FileID        pgtype.UUID        `json:"file_id"`
+	Title         string             `json:"title"`
+	Summary       string             `json:"summary"`
+	PageCount     int32              `json:"page_count"`
+	Model         *string            `json:"model"`
+	PromptVersion *string            `json:"prompt_version"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type OcrLlmUsage struct {
+	ID               pgtype.UUID        `json:"id"`
+	FileID           pgtype.UUID        `json:"file_id"`
+	PageID           pgtype.UUID        `json:"page_id"`
+	Agent            string             `json:"agent"`
+	Model            string             `json:"model"`
+	Provider         string             `json:"provider"`
+	PromptTokens     int64              `json:"prompt_tokens"`
+	CompletionTokens int64              `json:"completion_tokens"`
+	Cost             float64            `json:"cost"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
 }
 
 type OcrOutbox struct {
@@ -26,3 +74,54 @@ type OcrOutbox struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	PublishedAt pgtype.Timestamptz `json:"published_at"`
 }
+
+type OcrPageEntity struct {
+	ID          pgtype.UUID        `json:"id"`
+	PageID      pgtype.UUID        `json:"page_id"`
+	FileID      pgtype.UUID        `json:"file_id"`
+	EntityType  string             `json:"entity_type"`
+	Detector    string             `json:"detector"`
+	StartOffset int32              `json:"start_offset"`
+	EndOffset   int32              `json:"end_offset"`
+	Boxes       []byte             `json:"boxes"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type OcrPageExtraction struct {
+	PageID       pgtype.UUID        `json:"page_id"`
+	SchemaID     pgtype.UUID        `json:"schema_id"`
+	FileID       pgtype.UUID        `json:"file_id"`
+	Data         []byte             `json:"data"`
+	ErrorMessage *string            `json:"error_message"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type OcrPageLayout struct {
+	PageID    pgtype.UUID        `json:"page_id"`
+	FileID    pgtype.UUID        `json:"file_id"`
+	Source    string             `json:"source"`
+	Layout    []byte             `json:"layout"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type OcrPageRedaction struct {
+	PageID      pgtype.UUID        `json:"page_id"`
+	FileID      pgtype.UUID        `json:"file_id"`
+	TextContent string             `json:"text_content"`
+	ImageKey    *string            `json:"image_key"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type OcrPageTranslation struct {
+	PageID        pgtype.UUID        `json:"page_id"`
+	Language      string             `json:"language"`
+	FileID        pgtype.UUID        `json:"file_id"`
+	TextContent   string             `json:"text_content"`
+	Model         *string            `json:"model"`
+	Provider      *string            `json:"provider"`
+	PromptVersion *string            `json:"prompt_version"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
@@ -11,14 +11,51 @@ import (
 )
 
 type Querier interface {
+	CountFilePagesByFileID(ctx context.Context, arg CountFilePagesByFileIDParams) (int64, error)
+	CreateExtractionSchema(ctx context.Context, arg CreateExtractionSchemaParams) (OcrExtractionSchema, error)
 	CreateFilePage(ctx context.Context, arg CreateFilePageParams) error
+	CreateLlmUsage(ctx context.Context, arg CreateLlmUsageParams) error
 	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
+	CreatePageEntity(ctx context.Context, arg CreatePageEntityParams) error
+	CreatePageLayout(ctx context.Context, arg CreatePageLayoutParams) error
+	DeleteExtractionSchema(ctx context.Context, arg DeleteExtractionSchemaParams) error
 	DeleteFilePagesByFileID(ctx context.Context, fileID pgtype.UUID) error
+	DeleteFileStateByFileID(ctx context.Context, fileID pgtype.UUID) error
+	DeleteFileSummaryByFileID(ctx context.Context, fileID pgtype.UUID) error
+	DeleteLlmUsageByFileID(ctx context.Context, fileID pgtype.UUID) error
+	DeletePageEntitiesByPageID(ctx context.Context, pageID pgtype.UUID) error
+	FileBelongsToTenant(ctx context.Context, arg FileBelongsToTenantParams) (bool, error)
+	GetDuplicateFilePage(ctx context.Context, arg GetDuplicateFilePageParams) (GetDuplicateFilePageRow, error)
+	GetExtractionSchemaByID(ctx context.Context, arg GetExtractionSchemaByIDParams) (OcrExtractionSchema, error)
+	GetExtractionSchemas(ctx context.Context, arg GetExtractionSchemasParams) ([]GetExtractionSchemasRow, error)
+	GetFileLlmUsage(ctx context.Context, fileID pgtype.UUID) ([]GetFileLlmUsageRow, error)
 	GetFilePageContentByID(ctx context.Context, id pgtype.UUID) (*string, error)
-	GetFilePagesByFileID(ctx context.Context, arg GetFilePagesByFileIDParams) ([]GetFilePagesByFileIDRow, error)
+	GetFilePageLayouts(ctx context.Context, fileID pgtype.UUID) ([]GetFilePageLayoutsRow, error)
+	GetFilePagesByFileID(ctx context.Context, arg GetFilePagesByFileIDParams) ([]OcrFilePage, error)
+	GetFilePagesByFileIDDesc(ctx context.Context, arg GetFilePagesByFileIDDescParams) ([]OcrFilePage, error)
+	GetFileProcessingStatus(ctx context.Context, arg GetFileProcessingStatusParams) (GetFileProcessingStatusRow, error)
+	GetFileSummary(ctx context.Context, fileID pgtype.UUID) (OcrFileSummary, error)
+	GetFirstFilePagesText(ctx context.Context, arg GetFirstFilePagesTextParams) ([]GetFirstFilePagesTextRow, error)
 	GetOutboxUnpublishedEvents(ctx context.Context, limit int32) ([]GetOutboxUnpublishedEventsRow, error)
+	GetPageExtractionsByFileID(ctx context.Context, fileID pgtype.UUID) ([]GetPageExtractionsByFileIDRow, error)
+	GetPageFiles(ctx context.Context, arg GetPageFilesParams) ([]GetPageFilesRow, error)
+	GetPageLayout(ctx context.Context, pageID pgtype.UUID) (OcrPageLayout, error)
+	GetPageRedaction(ctx context.Context, pageID pgtype.UUID) (OcrPageRedaction, error)
+	GetPageRedactionsByFileID(ctx context.Context, fileID pgtype.UUID) ([]GetPageRedactionsByFileIDRow, error)
+	GetPageTranslation(ctx context.Context, arg GetPageTranslationParams) (string, error)
+	IsFileClassified(ctx context.Context, fileID pgtype.UUID) (bool, error)
 	MarkEventAsPublished(ctx context.Context, eventID pgtype.UUID) error
+	PageBelongsToTenant(ctx context.Context, arg PageBelongsToTenantParams) (bool, error)
+	SetFileClassified(ctx context.Context, arg SetFileClassifiedParams) (int64, error)
+	SetFilePageCount(ctx context.Context, arg SetFilePageCountParams) error
+	SetFilesTrashed(ctx context.Context, arg SetFilesTrashedParams) error
+	UpdateExtractionSchema(ctx context.Context, arg UpdateExtractionSchemaParams) (OcrExtractionSchema, error)
+	UpdateFilePageError(ctx context.Context, arg UpdateFilePageErrorParams) error
 	UpdateFilePageText(ctx context.Context, arg UpdateFilePageTextParams) error
+	UpsertFileSummary(ctx context.Context, arg UpsertFileSummaryParams) error
+	UpsertPageExtraction(ctx context.Context, arg UpsertPageExtractionParams) error
+	UpsertPageRedaction(ctx context.Context, arg UpsertPageRedactionParams) error
+	UpsertPageTranslation(ctx context.Context, arg UpsertPageTranslationParams) error
 }
 
 var _ Querier = (*Queries)(nil)
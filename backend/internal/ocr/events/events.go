@@ -6,4 +6,8 @@ const (
 	FILE_PAGE_REGISTERED_EVENT    string = "ocr.file.page.registered"
 	FILE_PAGES_DELETED_EVENT      string = "ocr.file.pages.deleted"
 	FILE_PAGE_OCR_GENERATED_EVENT string = "ocr.file.page.ocr_generated"
+	FILE_CLASSIFIED_EVENT         string = "ocr.file.classified"
+	FILE_SUMMARIZED_EVENT         string = "ocr.file.summarized"
+	FILE_PAGE_OCR_FAILED_EVENT    string = "ocr.file.page.ocr_failed"
+	LLM_BUDGET_EXHAUSTED_EVENT    string = "ocr.llm.budget_exhausted"
 )
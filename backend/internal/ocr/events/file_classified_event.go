@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type FileClassifiedEvent struct {
+	Id      ulid.ULID
+	Payload *ocr.FileClassifiedEventData
+}
+
+var _ core.EventSpec = (*FileClassifiedEvent)(nil)
+
+func NewFileClassifiedEvent(
+	payload *ocr.FileClassifiedEventData,
+) *FileClassifiedEvent {
+	return &FileClassifiedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewFileClassifiedEventFromMessage(
+	msg jetstream.Msg,
+) (*FileClassifiedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &ocr.FileClassifiedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &FileClassifiedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *FileClassifiedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *FileClassifiedEvent) Type() string {
+	return FILE_CLASSIFIED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *FileClassifiedEvent) Data() proto.Message {
+	return ev.Payload
+}
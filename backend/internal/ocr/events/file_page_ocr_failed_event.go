@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type FilePageOcrFailedEvent struct {
+	Id      ulid.ULID
+	Payload *ocr.FilePageOcrFailedEventData
+}
+
+var _ core.EventSpec = (*FilePageOcrFailedEvent)(nil)
+
+func NewFilePageOcrFailedEvent(
+	payload *ocr.FilePageOcrFailedEventData,
+) *FilePageOcrFailedEvent {
+	return &FilePageOcrFailedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewFilePageOcrFailedEventFromMessage(
+	msg jetstream.Msg,
+) (*FilePageOcrFailedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &ocr.FilePageOcrFailedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &FilePageOcrFailedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *FilePageOcrFailedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *FilePageOcrFailedEvent) Type() string {
+	return FILE_PAGE_OCR_FAILED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *FilePageOcrFailedEvent) Data() proto.Message {
+	return ev.Payload
+}
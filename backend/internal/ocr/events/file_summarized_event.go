@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type FileSummarizedEvent struct {
+	Id      ulid.ULID
+	Payload *ocr.FileSummarizedEventData
+}
+
+var _ core.EventSpec = (*FileSummarizedEvent)(nil)
+
+func NewFileSummarizedEvent(
+	payload *ocr.FileSummarizedEventData,
+) *FileSummarizedEvent {
+	return &FileSummarizedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewFileSummarizedEventFromMessage(
+	msg jetstream.Msg,
+) (*FileSummarizedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &ocr.FileSummarizedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &FileSummarizedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *FileSummarizedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *FileSummarizedEvent) Type() string {
+	return FILE_SUMMARIZED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *FileSummarizedEvent) Data() proto.Message {
+	return ev.Payload
+}
@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/ocr"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type LlmBudgetExhaustedEvent struct {
+	Id      ulid.ULID
+	Payload *ocr.LlmBudgetExhaustedEventData
+}
+
+var _ core.EventSpec = (*LlmBudgetExhaustedEvent)(nil)
+
+func NewLlmBudgetExhaustedEvent(
+	payload *ocr.LlmBudgetExhaustedEventData,
+) *LlmBudgetExhaustedEvent {
+	return &LlmBudgetExhaustedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewLlmBudgetExhaustedEventFromMessage(
+	msg jetstream.Msg,
+) (*LlmBudgetExhaustedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &ocr.LlmBudgetExhaustedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &LlmBudgetExhaustedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *LlmBudgetExhaustedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *LlmBudgetExhaustedEvent) Type() string {
+	return LLM_BUDGET_EXHAUSTED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *LlmBudgetExhaustedEvent) Data() proto.Message {
+	return ev.Payload
+}
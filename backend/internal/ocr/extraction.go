@@ -0,0 +1,38 @@
+package ocr
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+const extractionSchemaURL = "extraction_schema.json"
+
+func CompileExtractionSchema(schema []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("invalid json schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(extractionSchemaURL, doc); err != nil {
+		return nil, fmt.Errorf("invalid json schema: %w", err)
+	}
+
+	compiled, err := compiler.Compile(extractionSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json schema: %w", err)
+	}
+
+	return compiled, nil
+}
+
+func ValidateExtraction(schema *jsonschema.Schema, data []byte) error {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid json document: %w", err)
+	}
+
+	return schema.Validate(doc)
+}
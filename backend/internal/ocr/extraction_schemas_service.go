@@ -0,0 +1,288 @@
+package ocr
+
+import (
+	"backend/gen/core"
+	"backend/gen/ocr"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/service"
+	ocrdb "backend/internal/ocr/db"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ExtractionSchemaService struct {
+	ocr.UnimplementedExtractionSchemaServiceServer
+	db     *ocrdb.Queries
+	redact bool
+}
+
+var _ ocr.ExtractionSchemaServiceServer = (*ExtractionSchemaService)(nil)
+var _ service.Service = (*ExtractionSchemaService)(nil)
+
+func NewExtractionSchemaService(
+	cfg *config.AppConfig,
+	db *ocrdb.Queries,
+) *ExtractionSchemaService {
+	return &ExtractionSchemaService{
+		db:     db,
+		redact: cfg.Redaction.Enabled,
+	}
+}
+
+// CreateExtractionSchema implements ocr.ExtractionSchemaServiceServer.
+func (s *ExtractionSchemaService) CreateExtractionSchema(
+	ctx context.Context,
+	req *ocr.CreateExtractionSchemaRequest,
+) (*ocr.ExtractionSchema, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	if _, err := CompileExtractionSchema([]byte(req.JsonSchema)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id := ulid.MustNew(
+		ulid.Timestamp(time.Now()),
+		ulid.DefaultEntropy(),
+	)
+
+	schema, err := s.db.CreateExtractionSchema(ctx, ocrdb.CreateExtractionSchemaParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		Name:        req.Name,
+		Description: req.Description,
+		JsonSchema:  []byte(req.JsonSchema),
+		TenantID:    auth.Tenant(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return extractionSchemaToProto(schema), nil
+}
+
+// GetExtractionSchemas implements ocr.ExtractionSchemaServiceServer.
+func (s *ExtractionSchemaService) GetExtractionSchemas(
+	ctx context.Context,
+	req *ocr.GetExtractionSchemasRequest,
+) (*ocr.GetExtractionSchemasResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := max(limit*(req.PageNumber-1), 0)
+
+	result, err := s.db.GetExtractionSchemas(ctx, ocrdb.GetExtractionSchemasParams{
+		TenantID: auth.Tenant(ctx),
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make([]*ocr.ExtractionSchema, len(result))
+	for i, schema := range result {
+		schemas[i] = extractionSchemaToProto(ocrdb.OcrExtractionSchema{
+			ID:          schema.ID,
+			Name:        schema.Name,
+			Description: schema.Description,
+			JsonSchema:  schema.JsonSchema,
+			CreatedAt:   schema.CreatedAt,
+			UpdatedAt:   schema.UpdatedAt,
+		})
+	}
+
+	var totalItems int32
+	if len(result) > 0 {
+		totalItems = int32(result[0].Total)
+	}
+
+	pageNumber := max(req.PageNumber, 1)
+	pageSize := int32(min(len(result), int(limit)))
+
+	pagination := &core.Pagination{
+		PageNumber:  pageNumber,
+		PageSize:    pageSize,
+		TotalItems:  totalItems,
+		HasNextPage: int32(pageNumber*limit) < totalItems,
+	}
+
+	return &ocr.GetExtractionSchemasResponse{
+		Schemas:    schemas,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetExtractionSchema implements ocr.ExtractionSchemaServiceServer.
+func (s *ExtractionSchemaService) GetExtractionSchema(
+	ctx context.Context,
+	req *ocr.GetExtractionSchemaRequest,
+) (*ocr.ExtractionSchema, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schema id: %v", err)
+	}
+
+	schema, err := s.db.GetExtractionSchemaByID(ctx, ocrdb.GetExtractionSchemaByIDParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: auth.Tenant(ctx),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "extraction schema not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return extractionSchemaToProto(schema), nil
+}
+
+// UpdateExtractionSchema implements ocr.ExtractionSchemaServiceServer.
+func (s *ExtractionSchemaService) UpdateExtractionSchema(
+	ctx context.Context,
+	req *ocr.UpdateExtractionSchemaRequest,
+) (*ocr.ExtractionSchema, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schema id: %v", err)
+	}
+
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	if _, err := CompileExtractionSchema([]byte(req.JsonSchema)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	schema, err := s.db.UpdateExtractionSchema(ctx, ocrdb.UpdateExtractionSchemaParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		Name:        req.Name,
+		Description: req.Description,
+		JsonSchema:  []byte(req.JsonSchema),
+		TenantID:    auth.Tenant(ctx),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "extraction schema not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return extractionSchemaToProto(schema), nil
+}
+
+// DeleteExtractionSchema implements ocr.ExtractionSchemaServiceServer.
+func (s *ExtractionSchemaService) DeleteExtractionSchema(
+	ctx context.Context,
+	req *ocr.DeleteExtractionSchemaRequest,
+) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schema id: %v", err)
+	}
+
+	if err := s.db.DeleteExtractionSchema(ctx, ocrdb.DeleteExtractionSchemaParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: auth.Tenant(ctx),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetFileExtractions implements ocr.ExtractionSchemaServiceServer.
+func (s *ExtractionSchemaService) GetFileExtractions(
+	ctx context.Context,
+	req *ocr.GetFileExtractionsRequest,
+) (*ocr.GetFileExtractionsResponse, error) {
+	fileId, err := uuid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid file key")
+	}
+
+	// Extractions are made from the original text
+	if s.redact && !auth.HasScope(ctx, auth.ScopeReadUnredacted) {
+		return nil, status.Errorf(codes.PermissionDenied, "extractions require the %s scope", auth.ScopeReadUnredacted)
+	}
+
+	file := pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	}
+
+	owned, err := s.db.FileBelongsToTenant(ctx, ocrdb.FileBelongsToTenantParams{
+		FileID:   file,
+		TenantID: auth.Tenant(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, status.Errorf(codes.NotFound, "file not found")
+	}
+
+	result, err := s.db.GetPageExtractionsByFileID(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	extractions := make([]*ocr.PageExtraction, len(result))
+	for i, extraction := range result {
+		extractions[i] = &ocr.PageExtraction{
+			PageId:     extraction.PageID.String(),
+			PageNumber: extraction.PageNumber + 1,
+			SchemaId:   extraction.SchemaID.String(),
+			Data:       string(extraction.Data),
+			CreatedAt:  extraction.CreatedAt.Time.UTC().Format(time.RFC3339),
+		}
+		if extraction.ErrorMessage != nil {
+			extractions[i].ErrorMessage = *extraction.ErrorMessage
+		}
+	}
+
+	return &ocr.GetFileExtractionsResponse{
+		Extractions: extractions,
+	}, nil
+}
+
+// Register implements service.Service.
+func (s *ExtractionSchemaService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	ocr.RegisterExtractionSchemaServiceHandlerServer(ctx, mux, s)
+}
+
+func extractionSchemaToProto(schema ocrdb.OcrExtractionSchema) *ocr.ExtractionSchema {
+	return &ocr.ExtractionSchema{
+		Id:          schema.ID.String(),
+		Name:        schema.Name,
+		Description: schema.Description,
+		JsonSchema:  string(schema.JsonSchema),
+		CreatedAt:   schema.CreatedAt.Time.UTC().Format(time.RFC3339),
+		UpdatedAt:   schema.UpdatedAt.Time.UTC().Format(time.RFC3339),
+	}
+}
@@ -2,17 +2,22 @@ package ocr
 
 import (
 	"backend/gen/ocr"
+	"backend/internal/core"
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/nats"
 	ocrdb "backend/internal/ocr/db"
 	"backend/internal/ocr/events"
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/oklog/ulid/v2"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type FilePageRenderedConsumer struct {
@@ -88,7 +93,7 @@ func (c *FilePageRenderedConsumer) handler(
 		return err
 	}
 
-	err = qtx.CreateFilePage(ctx, ocrdb.CreateFilePageParams{
+	params := ocrdb.CreateFilePageParams{
 		ID: pgtype.UUID{
 			Bytes: id,
 			Valid: true,
@@ -99,24 +104,117 @@ func (c *FilePageRenderedConsumer) handler(
 		},
 		PageNumber:   event.Payload.PageNumber,
 		PageImageKey: event.Payload.PageImageKey,
-	})
+		TenantID:     auth.EventTenant(event.Payload.TenantId),
+	}
+	if event.Payload.ContentHash != "" {
+		params.ContentHash = &event.Payload.ContentHash
+	}
+
+	err = qtx.CreateFilePage(ctx, params)
 	if err != nil {
 		span.RecordError(err)
 		return err
 	}
 
-	ev := events.NewFilePageRegisteredEvent(
-		&ocr.FilePageRegisteredEventData{
-			Id:           id.String(),
-			FileId:       fileKey.String(),
-			PageNumber:   event.Payload.PageNumber,
-			PageImageKey: event.Payload.PageImageKey,
-		},
-	)
+	// The page count of the document tells whether every page was rendered
+	if event.Payload.PageCount > 0 {
+		err = qtx.SetFilePageCount(ctx, ocrdb.SetFilePageCountParams{
+			FileID:    params.FileID,
+			TenantID:  params.TenantID,
+			PageCount: &event.Payload.PageCount,
+		})
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	// Store word boxes in their protobuf encoding
+	if event.Payload.Layout != nil {
+		layout, err := proto.Marshal(event.Payload.Layout)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		err = qtx.CreatePageLayout(ctx, ocrdb.CreatePageLayoutParams{
+			PageID: pgtype.UUID{
+				Bytes: id,
+				Valid: true,
+			},
+			FileID: pgtype.UUID{
+				Bytes: fileKey,
+				Valid: true,
+			},
+			Source: event.Payload.Layout.Source,
+			Layout: layout,
+		})
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	// Pages already OCR'd in another file reuse that text and skip the LLM
+	cloned, err := c.clone(ctx, qtx, params)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	var ev core.EventSpec
+	switch {
+	case event.Payload.RenderError != "":
+		// Pages that could not be rendered fail without OCR, so the file can
+		// still complete
+		err = qtx.UpdateFilePageError(ctx, ocrdb.UpdateFilePageErrorParams{
+			ID:           params.ID,
+			ErrorMessage: &event.Payload.RenderError,
+		})
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		ev = events.NewFilePageOcrFailedEvent(
+			&ocr.FilePageOcrFailedEventData{
+				Id:         id.String(),
+				FileId:     fileKey.String(),
+				PageNumber: event.Payload.PageNumber,
+				Reason:     event.Payload.RenderError,
+				TenantId:   params.TenantID,
+				PageCount:  event.Payload.PageCount,
+			},
+		)
+	case cloned:
+		ev = events.NewFilePageOcrGeneratedEvent(
+			&ocr.FilePageOcrGeneratedEventData{
+				Id:                 id.String(),
+				FileId:             fileKey.String(),
+				PageNumber:         event.Payload.PageNumber,
+				PageImageKey:       event.Payload.PageImageKey,
+				ExtractionSchemaId: event.Payload.ExtractionSchemaId,
+				PageCount:          event.Payload.PageCount,
+				TenantId:           params.TenantID,
+			},
+		)
+	default:
+		ev = events.NewFilePageRegisteredEvent(
+			&ocr.FilePageRegisteredEventData{
+				Id:                 id.String(),
+				FileId:             fileKey.String(),
+				PageNumber:         event.Payload.PageNumber,
+				PageImageKey:       event.Payload.PageImageKey,
+				ExtractionSchemaId: event.Payload.ExtractionSchemaId,
+				PageCount:          event.Payload.PageCount,
+				TenantId:           params.TenantID,
+			},
+		)
+	}
 
 	eventId := event.Id
 	eventType := ev.Type()
-	payload, err := protojson.Marshal(ev.Payload)
+	payload, err := protojson.Marshal(ev.Data())
 	if err != nil {
 		span.RecordError(err)
 		return err
@@ -138,3 +236,40 @@ func (c *FilePageRenderedConsumer) handler(
 
 	return nil
 }
+
+// clone copies the OCR result of an earlier page with the same content hash
+// into the new page.
+func (c *FilePageRenderedConsumer) clone(
+	ctx context.Context,
+	qtx *ocrdb.Queries,
+	page ocrdb.CreateFilePageParams,
+) (bool, error) {
+	if page.ContentHash == nil {
+		return false, nil
+	}
+
+	source, err := qtx.GetDuplicateFilePage(ctx, ocrdb.GetDuplicateFilePageParams{
+		TenantID:    page.TenantID,
+		FileID:      page.FileID,
+		ContentHash: page.ContentHash,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = qtx.UpdateFilePageText(ctx, ocrdb.UpdateFilePageTextParams{
+		ID:            page.ID,
+		TextContent:   source.TextContent,
+		OcrModel:      source.OcrModel,
+		OcrProvider:   source.OcrProvider,
+		PromptVersion: source.PromptVersion,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
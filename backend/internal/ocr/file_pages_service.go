@@ -3,39 +3,66 @@ package ocr
 import (
 	"backend/gen/core"
 	"backend/gen/ocr"
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/keyset"
 	"backend/internal/infrastructure/service"
 	"backend/internal/infrastructure/storage"
 	ocrdb "backend/internal/ocr/db"
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type FilesService struct {
 	ocr.UnimplementedFilePagesServiceServer
-	s3 *s3.PresignClient
-	db *ocrdb.Queries
+	s3     *s3.PresignClient
+	db     *ocrdb.Queries
+	audit  audit.Recorder
+	redact bool
 }
 
 var _ ocr.FilePagesServiceServer = (*FilesService)(nil)
 var _ service.Service = (*FilesService)(nil)
 
 func NewFilesService(
+	cfg *config.AppConfig,
 	s3 *s3.PresignClient,
 	db *ocrdb.Queries,
+	recorder audit.Recorder,
 ) *FilesService {
 	return &FilesService{
-		s3: s3,
-		db: db,
+		s3:     s3,
+		db:     db,
+		audit:  recorder,
+		redact: cfg.Redaction.Enabled,
 	}
 }
 
+// redacted reports whether the caller must only see redacted page content.
+func (f *FilesService) redacted(ctx context.Context) bool {
+	return f.redact && !auth.HasScope(ctx, auth.ScopeReadUnredacted)
+}
+
+// pagePosition is the keyset position of the last returned file page.
+type pagePosition struct {
+	Desc       bool  `json:"desc"`
+	PageNumber int32 `json:"page_number"`
+}
+
 // GetFilePages implements ocr.FilePagesServiceServer.
 func (f *FilesService) GetFilePages(
 	ctx context.Context,
@@ -53,16 +80,87 @@ func (f *FilesService) GetFilePages(
 		return nil, err
 	}
 
-	result, err := f.db.GetFilePagesByFileID(
-		ctx, ocrdb.GetFilePagesByFileIDParams{
-			FileID: pgtype.UUID{
-				Bytes: fileId,
-				Valid: true,
-			},
-			Limit:  limit,
-			Offset: offset,
+	sortDesc, err := keyset.SortDesc(req.SortOrder, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Page numbers are 1-based in the API
+	filter := ocrdb.CountFilePagesByFileIDParams{
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
 		},
-	)
+		TenantID: auth.Tenant(ctx),
+	}
+	if req.FromPage > 0 {
+		filter.FromPage = lo.ToPtr(req.FromPage - 1)
+	}
+	if req.ToPage > 0 {
+		filter.ToPage = lo.ToPtr(req.ToPage - 1)
+	}
+
+	// One more page is fetched to know whether there is a next page
+	params := ocrdb.GetFilePagesByFileIDParams{
+		FileID:   filter.FileID,
+		TenantID: filter.TenantID,
+		FromPage: filter.FromPage,
+		ToPage:   filter.ToPage,
+		Limit:    limit + 1,
+		Offset:   offset,
+	}
+	if req.Cursor != "" {
+		var position pagePosition
+		if err := keyset.DecodeCursor(req.Cursor, &position); err != nil {
+			return nil, err
+		}
+		if position.Desc != sortDesc {
+			return nil, status.Errorf(codes.InvalidArgument, "cursor does not match the sort order")
+		}
+		params.AfterPage = &position.PageNumber
+		params.Offset = 0
+	}
+
+	var result []ocrdb.OcrFilePage
+	if sortDesc {
+		result, err = f.db.GetFilePagesByFileIDDesc(ctx, ocrdb.GetFilePagesByFileIDDescParams(params))
+	} else {
+		result, err = f.db.GetFilePagesByFileID(ctx, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(result) > int(limit)
+	if hasNextPage {
+		result = result[:limit]
+	}
+
+	// Counting scans every matching page, so it is skipped for cursors
+	var totalItems int64
+	if req.Cursor == "" && !req.SkipTotal {
+		totalItems, err = f.db.CountFilePagesByFileID(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Callers without unredacted access only get the images of pages whose
+	// redaction finished, blacked out when entities were found on them
+	redacted := f.redacted(ctx)
+	redactions := map[pgtype.UUID]ocrdb.GetPageRedactionsByFileIDRow{}
+	if redacted {
+		rows, err := f.db.GetPageRedactionsByFileID(ctx, pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			redactions[row.PageID] = row
+		}
+	}
 
 	pages := make([]*ocr.FilePage, len(result))
 	for i, page := range result {
@@ -70,29 +168,65 @@ func (f *FilesService) GetFilePages(
 			Id:         page.ID.String(),
 			PageNumber: page.PageNumber + 1,
 		}
+		if page.OcrModel != nil {
+			pages[i].OcrModel = *page.OcrModel
+		}
+		if page.OcrProvider != nil {
+			pages[i].OcrProvider = *page.OcrProvider
+		}
+		if page.PromptVersion != nil {
+			pages[i].PromptVersion = *page.PromptVersion
+		}
 
-		if imageUrl, err := f.s3.PresignGetObject(ctx, &s3.GetObjectInput{
-			Key:    &page.PageImageKey,
-			Bucket: aws.String(storage.BUCKET_NAME),
-		}); err == nil {
-			pages[i].ImageUrl = imageUrl.URL
+		// Pages that could not be rendered have no image
+		if page.PageImageKey == "" {
+			continue
 		}
-	}
 
-	var totalItems int32
-	if len(result) > 0 {
-		totalItems = int32(result[0].Total)
+		if redacted {
+			redaction, ok := redactions[page.ID]
+			switch {
+			case !ok:
+				// Redaction is still running
+				continue
+			case redaction.ImageKey != nil:
+				// Thumbnails are rendered from the original page image
+				pages[i].ImageUrl = f.presign(ctx, *redaction.ImageKey)
+				continue
+			case redaction.HasEntities:
+				// Entities were found but could not be located on the image
+				continue
+			}
+		}
+
+		pages[i].ImageUrl = f.presign(ctx, page.PageImageKey)
+		pages[i].ThumbnailSmallUrl = f.presign(ctx, storage.PageThumbnailKey(page.PageImageKey, storage.ThumbnailSmall))
+		pages[i].ThumbnailMediumUrl = f.presign(ctx, storage.PageThumbnailKey(page.PageImageKey, storage.ThumbnailMedium))
 	}
 
 	pageNumber := max(req.PageNumber, 1)
-	pageSize := int32(min(len(result), int(limit)))
+	if req.Cursor != "" {
+		pageNumber = 0
+	}
 
 	pagination := &core.Pagination{
 		PageNumber:  pageNumber,
-		PageSize:    pageSize,
-		TotalItems:  totalItems,
-		HasNextPage: int32(pageNumber*limit) < totalItems,
+		PageSize:    int32(len(result)),
+		TotalItems:  int32(totalItems),
+		HasNextPage: hasNextPage,
 	}
+	if hasNextPage {
+		pagination.NextCursor = keyset.EncodeCursor(pagePosition{
+			Desc:       sortDesc,
+			PageNumber: result[len(result)-1].PageNumber,
+		})
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileViewed,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   req.FileKey,
+	})
 
 	return &ocr.GetFilePagesResponse{
 		Pages:      pages,
@@ -110,6 +244,72 @@ func (f *FilesService) GetFilePageContent(
 		return nil, err
 	}
 
+	owned, err := f.db.PageBelongsToTenant(ctx, ocrdb.PageBelongsToTenantParams{
+		ID: pgtype.UUID{
+			Bytes: pageId,
+			Valid: true,
+		},
+		TenantID: auth.Tenant(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, status.Errorf(codes.NotFound, "file page not found")
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionPageViewed,
+		ResourceType: audit.ResourcePage,
+		ResourceID:   req.Id,
+	})
+
+	redacted := f.redacted(ctx)
+
+	if req.Language != "" {
+		// Translations are made from the original text
+		if redacted {
+			return nil, status.Errorf(codes.PermissionDenied, "translations require the %s scope", auth.ScopeReadUnredacted)
+		}
+
+		translation, err := f.db.GetPageTranslation(ctx, ocrdb.GetPageTranslationParams{
+			PageID: pgtype.UUID{
+				Bytes: pageId,
+				Valid: true,
+			},
+			Language: req.Language,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "file page translation not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &ocr.GetFilePageContentResponse{
+			Content:  translation,
+			Language: req.Language,
+		}, nil
+	}
+
+	if redacted {
+		redaction, err := f.db.GetPageRedaction(ctx, pgtype.UUID{
+			Bytes: pageId,
+			Valid: true,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "file page content not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &ocr.GetFilePageContentResponse{
+			Content:  redaction.TextContent,
+			Redacted: true,
+		}, nil
+	}
+
 	content, err := f.db.GetFilePageContentByID(ctx, pgtype.UUID{
 		Bytes: pageId,
 		Valid: true,
@@ -127,7 +327,230 @@ func (f *FilesService) GetFilePageContent(
 	return nil, status.Errorf(codes.NotFound, "file page content not found")
 }
 
+// GetFileUsage implements ocr.FilePagesServiceServer.
+func (f *FilesService) GetFileUsage(
+	ctx context.Context,
+	req *ocr.GetFileUsageRequest,
+) (*ocr.GetFileUsageResponse, error) {
+	fileId, err := uuid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	if err := f.checkFile(ctx, fileId); err != nil {
+		return nil, err
+	}
+
+	result, err := f.db.GetFileLlmUsage(ctx, pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ocr.GetFileUsageResponse{
+		Models: make([]*ocr.ModelUsage, len(result)),
+	}
+	for i, usage := range result {
+		resp.Models[i] = &ocr.ModelUsage{
+			Agent:            usage.Agent,
+			Model:            usage.Model,
+			Provider:         usage.Provider,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			Cost:             usage.Cost,
+		}
+		resp.PromptTokens += usage.PromptTokens
+		resp.CompletionTokens += usage.CompletionTokens
+		resp.Cost += usage.Cost
+	}
+
+	return resp, nil
+}
+
+// GetFileSummary implements ocr.FilePagesServiceServer.
+func (f *FilesService) GetFileSummary(
+	ctx context.Context,
+	req *ocr.GetFileSummaryRequest,
+) (*ocr.GetFileSummaryResponse, error) {
+	fileId, err := uuid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	// Summaries are generated from the original text
+	if f.redacted(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "summaries require the %s scope", auth.ScopeReadUnredacted)
+	}
+
+	if err := f.checkFile(ctx, fileId); err != nil {
+		return nil, err
+	}
+
+	summary, err := f.db.GetFileSummary(ctx, pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "file summary not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileViewed,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   req.FileKey,
+	})
+
+	resp := &ocr.GetFileSummaryResponse{
+		Title:     summary.Title,
+		Summary:   summary.Summary,
+		PageCount: summary.PageCount,
+		UpdatedAt: summary.UpdatedAt.Time.UTC().Format(time.RFC3339),
+	}
+	if summary.Model != nil {
+		resp.Model = *summary.Model
+	}
+	if summary.PromptVersion != nil {
+		resp.PromptVersion = *summary.PromptVersion
+	}
+
+	return resp, nil
+}
+
+// GetFileHocr implements ocr.FilePagesServiceServer.
+func (f *FilesService) GetFileHocr(
+	ctx context.Context,
+	req *ocr.ExportFileLayoutRequest,
+) (*ocr.ExportFileLayoutResponse, error) {
+	pages, err := f.layoutPages(ctx, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	if err := WriteHocr(&b, pages); err != nil {
+		return nil, err
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileExported,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   req.FileKey,
+	})
+
+	return &ocr.ExportFileLayoutResponse{
+		Content:     b.String(),
+		ContentType: "application/xhtml+xml",
+	}, nil
+}
+
+// GetFileAlto implements ocr.FilePagesServiceServer.
+func (f *FilesService) GetFileAlto(
+	ctx context.Context,
+	req *ocr.ExportFileLayoutRequest,
+) (*ocr.ExportFileLayoutResponse, error) {
+	pages, err := f.layoutPages(ctx, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	if err := WriteAlto(&b, pages); err != nil {
+		return nil, err
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileExported,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   req.FileKey,
+	})
+
+	return &ocr.ExportFileLayoutResponse{
+		Content:     b.String(),
+		ContentType: "application/xml",
+	}, nil
+}
+
+// layoutPages loads the word boxes of every page of a file that has them.
+func (f *FilesService) layoutPages(ctx context.Context, fileKey string) ([]LayoutPage, error) {
+	fileId, err := uuid.Parse(fileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	// Word boxes carry the original text
+	if f.redacted(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "layout export requires the %s scope", auth.ScopeReadUnredacted)
+	}
+
+	if err := f.checkFile(ctx, fileId); err != nil {
+		return nil, err
+	}
+
+	result, err := f.db.GetFilePageLayouts(ctx, pgtype.UUID{
+		Bytes: fileId,
+		Valid: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, status.Errorf(codes.NotFound, "file layout not found")
+	}
+
+	pages := make([]LayoutPage, len(result))
+	for i, page := range result {
+		layout := &ocr.PageLayout{}
+		if err := proto.Unmarshal(page.Layout, layout); err != nil {
+			return nil, err
+		}
+		pages[i] = LayoutPage{
+			Number:   page.PageNumber,
+			ImageKey: page.PageImageKey,
+			Layout:   layout,
+		}
+	}
+
+	return pages, nil
+}
+
+// checkFile returns NotFound unless the file belongs to the caller's tenant.
+func (f *FilesService) checkFile(ctx context.Context, fileId uuid.UUID) error {
+	owned, err := f.db.FileBelongsToTenant(ctx, ocrdb.FileBelongsToTenantParams{
+		FileID: pgtype.UUID{
+			Bytes: fileId,
+			Valid: true,
+		},
+		TenantID: auth.Tenant(ctx),
+	})
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return status.Errorf(codes.NotFound, "file not found")
+	}
+
+	return nil
+}
+
 // Register implements service.Service.
 func (f *FilesService) Register(ctx context.Context, mux *runtime.ServeMux) {
 	ocr.RegisterFilePagesServiceHandlerServer(ctx, mux, f)
 }
+
+// presign returns a download URL for key, or an empty string when signing
+// fails.
+func (f *FilesService) presign(ctx context.Context, key string) string {
+	result, err := f.s3.PresignGetObject(ctx, &s3.GetObjectInput{
+		Key:    &key,
+		Bucket: aws.String(storage.BUCKET_NAME),
+	})
+	if err != nil {
+		return ""
+	}
+	return result.URL
+}
@@ -90,6 +90,30 @@ func (c *FilesDeletedConsumer) handler(
 			span.RecordError(err)
 			return err
 		}
+		err = qtx.DeleteLlmUsageByFileID(ctx, pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		})
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		err = qtx.DeleteFileSummaryByFileID(ctx, pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		})
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		err = qtx.DeleteFileStateByFileID(ctx, pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		})
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
 	}
 
 	fileKeys := lo.Map(ids, func(key string, _ int) string {
@@ -105,6 +129,7 @@ func (c *FilesDeletedConsumer) handler(
 	ev := ocrev.NewFilePagesDeletedEvent(
 		&ocr.FilePagesDeletedEventData{
 			FileKeys: fileKeys,
+			TenantId: event.Payload.TenantId,
 		},
 	)
 
@@ -1,6 +1,7 @@
 package ocr
 
 import (
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/nats"
 	"backend/internal/infrastructure/storage"
 	"backend/internal/ocr/events"
@@ -66,13 +67,15 @@ func (c *FilePagesDeletedConsumer) handler(
 	ctx, span := tracer.Start(ctx, "FilePagesDeletedConsumer.handler")
 	defer span.End()
 
+	tenant := auth.EventTenant(event.Payload.TenantId)
+
 	g, ctx := errgroup.WithContext(ctx)
 	g.SetLimit(concurrentDeletes)
 
 	for _, fileKey := range event.Payload.FileKeys {
 		fileKey := fileKey
 		g.Go(func() error {
-			return c.delete(ctx, fileKey)
+			return c.delete(ctx, tenant, fileKey)
 		})
 	}
 
@@ -86,6 +89,7 @@ func (c *FilePagesDeletedConsumer) handler(
 
 func (c *FilePagesDeletedConsumer) delete(
 	ctx context.Context,
+	tenant string,
 	fileKey string,
 ) error {
 	// get span from context
@@ -95,9 +99,10 @@ func (c *FilePagesDeletedConsumer) delete(
 
 	span.SetAttributes(
 		attribute.String("file_key", fileKey),
+		attribute.String("tenant_id", tenant),
 	)
 
-	prefix := PageImagePrefix(fileKey)
+	prefix := storage.PageImagePrefix(tenant, fileKey)
 
 	var objects []types.ObjectIdentifier
 	paginator := s3.NewListObjectsV2Paginator(c.s3, &s3.ListObjectsV2Input{
@@ -0,0 +1,95 @@
+package ocr
+
+import (
+	"backend/internal/infrastructure/nats"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/storage/events"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+)
+
+type FilesRestoredConsumer struct {
+	*nats.NatsConsumer[*events.FilesRestoredEvent]
+	db *ocrdb.Queries
+}
+
+func NewFilesRestoredConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+) *FilesRestoredConsumer {
+	name := "ocr_files_restored_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FilesRestoredConsumer{
+		db: db,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.STORAGE_CHANNEL,
+		events.STORAGE_FILES_RESTORED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilesRestoredEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR Files Restored Event Consumer",
+			FilterSubject: events.STORAGE_FILES_RESTORED_EVENT,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FilesRestoredConsumer) handler(
+	ctx context.Context,
+	event *events.FilesRestoredEvent,
+) error {
+	tracer := otel.Tracer("ocr.FilesRestoredConsumer")
+	ctx, span := tracer.Start(ctx, "FilesRestoredConsumer.handler")
+	defer span.End()
+
+	restoredAt, err := time.Parse(time.RFC3339Nano, event.Payload.RestoredAt)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	fileIds := make([]pgtype.UUID, len(event.Payload.FileKeys))
+	for i, key := range event.Payload.FileKeys {
+		id, err := uuid.Parse(key)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		fileIds[i] = pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		}
+	}
+
+	err = c.db.SetFilesTrashed(ctx, ocrdb.SetFilesTrashedParams{
+		FileIds:  fileIds,
+		TenantID: event.Payload.TenantId,
+		Trashed:  false,
+		ChangedAt: pgtype.Timestamptz{
+			Time:  restoredAt,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
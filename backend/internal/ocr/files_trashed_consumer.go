@@ -0,0 +1,97 @@
+package ocr
+
+import (
+	"backend/internal/infrastructure/nats"
+	ocrdb "backend/internal/ocr/db"
+	"backend/internal/storage/events"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+)
+
+type FilesTrashedConsumer struct {
+	*nats.NatsConsumer[*events.FilesTrashedEvent]
+	db *ocrdb.Queries
+}
+
+func NewFilesTrashedConsumer(
+	js jetstream.JetStream,
+	db *ocrdb.Queries,
+) *FilesTrashedConsumer {
+	name := "ocr_files_trashed_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FilesTrashedConsumer{
+		db: db,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.STORAGE_CHANNEL,
+		events.STORAGE_FILES_TRASHED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFilesTrashedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "OCR Files Trashed Event Consumer",
+			FilterSubject: events.STORAGE_FILES_TRASHED_EVENT,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FilesTrashedConsumer) handler(
+	ctx context.Context,
+	event *events.FilesTrashedEvent,
+) error {
+	tracer := otel.Tracer("ocr.FilesTrashedConsumer")
+	ctx, span := tracer.Start(ctx, "FilesTrashedConsumer.handler")
+	defer span.End()
+
+	trashedAt, err := time.Parse(time.RFC3339Nano, event.Payload.TrashedAt)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	fileIds := make([]pgtype.UUID, len(event.Payload.FileKeys))
+	for i, key := range event.Payload.FileKeys {
+		id, err := uuid.Parse(key)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		fileIds[i] = pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		}
+	}
+
+	// Pages of trashed files are hidden from reads and clones until the
+	// files are restored, events are ordered by their timestamp
+	err = c.db.SetFilesTrashed(ctx, ocrdb.SetFilesTrashedParams{
+		FileIds:  fileIds,
+		TenantID: event.Payload.TenantId,
+		Trashed:  true,
+		ChangedAt: pgtype.Timestamptz{
+			Time:  trashedAt,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
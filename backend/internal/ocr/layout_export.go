@@ -0,0 +1,139 @@
+package ocr
+
+import (
+	"backend/gen/ocr"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// LayoutPage is a page of a file with its word boxes, ordered by Number.
+type LayoutPage struct {
+	Number   int32
+	ImageKey string
+	Layout   *ocr.PageLayout
+}
+
+// WriteHocr writes the pages as an hOCR 1.2 document.
+func WriteHocr(w io.Writer, pages []LayoutPage) error {
+	b := &strings.Builder{}
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml">
+<head>
+<title></title>
+<meta http-equiv="Content-Type" content="text/html;charset=utf-8"/>
+<meta name="ocr-system" content="ocr-system"/>
+<meta name="ocr-capabilities" content="ocr_page ocr_line ocrx_word"/>
+</head>
+<body>
+`)
+
+	for p, page := range pages {
+		layout := page.Layout
+		fmt.Fprintf(b, "<div class=\"ocr_page\" id=\"page_%d\" title=\"image %s; bbox 0 0 %d %d; ppageno %d\">\n",
+			p+1, escape(`"`+path.Base(page.ImageKey)+`"`), layout.Width, layout.Height, page.Number)
+
+		for l, line := range layout.Lines {
+			fmt.Fprintf(b, "<span class=\"ocr_line\" id=\"line_%d_%d\" title=\"%s\">",
+				p+1, l+1, hocrBox(line.Box))
+
+			for i, word := range line.Words {
+				if i > 0 {
+					b.WriteByte(' ')
+				}
+				fmt.Fprintf(b, "<span class=\"ocrx_word\" id=\"word_%d_%d_%d\" title=\"%s; x_wconf %d\">%s</span>",
+					p+1, l+1, i+1, hocrBox(word.Box), int(word.Confidence*100), escape(word.Text))
+			}
+
+			b.WriteString("</span>\n")
+		}
+
+		b.WriteString("</div>\n")
+	}
+
+	b.WriteString("</body>\n</html>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteAlto writes the pages as an ALTO 4 document. Each page holds a single
+// text block as the engines do not report blocks.
+func WriteAlto(w io.Writer, pages []LayoutPage) error {
+	b := &strings.Builder{}
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<alto xmlns="http://www.loc.gov/standards/alto/ns-v4#" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://www.loc.gov/standards/alto/ns-v4# http://www.loc.gov/alto/v4/alto-4-2.xsd">
+<Description>
+<MeasurementUnit>pixel</MeasurementUnit>
+<OCRProcessing ID="ocr_processing"><ocrProcessingStep><processingSoftware><softwareName>ocr-system</softwareName></processingSoftware></ocrProcessingStep></OCRProcessing>
+</Description>
+<Layout>
+`)
+
+	for p, page := range pages {
+		layout := page.Layout
+		fmt.Fprintf(b, "<Page ID=\"page_%d\" PHYSICAL_IMG_NR=\"%d\" WIDTH=\"%d\" HEIGHT=\"%d\">\n",
+			p+1, page.Number+1, layout.Width, layout.Height)
+		fmt.Fprintf(b, "<PrintSpace HPOS=\"0\" VPOS=\"0\" WIDTH=\"%d\" HEIGHT=\"%d\">\n",
+			layout.Width, layout.Height)
+
+		if len(layout.Lines) > 0 {
+			fmt.Fprintf(b, "<TextBlock ID=\"block_%d\" %s>\n", p+1, altoBox(unionBox(layout.Lines)))
+
+			for l, line := range layout.Lines {
+				fmt.Fprintf(b, "<TextLine ID=\"line_%d_%d\" %s>", p+1, l+1, altoBox(line.Box))
+
+				for i, word := range line.Words {
+					if i > 0 {
+						b.WriteString("<SP/>")
+					}
+					fmt.Fprintf(b, "<String ID=\"word_%d_%d_%d\" CONTENT=\"%s\" %s WC=\"%.2f\"/>",
+						p+1, l+1, i+1, escape(word.Text), altoBox(word.Box), word.Confidence)
+				}
+
+				b.WriteString("</TextLine>\n")
+			}
+
+			b.WriteString("</TextBlock>\n")
+		}
+
+		b.WriteString("</PrintSpace>\n</Page>\n")
+	}
+
+	b.WriteString("</Layout>\n</alto>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func hocrBox(box *ocr.LayoutBox) string {
+	return fmt.Sprintf("bbox %d %d %d %d",
+		box.GetX(), box.GetY(), box.GetX()+box.GetWidth(), box.GetY()+box.GetHeight())
+}
+
+func altoBox(box *ocr.LayoutBox) string {
+	return fmt.Sprintf("HPOS=\"%d\" VPOS=\"%d\" WIDTH=\"%d\" HEIGHT=\"%d\"",
+		box.GetX(), box.GetY(), box.GetWidth(), box.GetHeight())
+}
+
+func unionBox(lines []*ocr.LayoutLine) *ocr.LayoutBox {
+	x0, y0 := lines[0].Box.GetX(), lines[0].Box.GetY()
+	x1, y1 := x0+lines[0].Box.GetWidth(), y0+lines[0].Box.GetHeight()
+	for _, line := range lines[1:] {
+		box := line.Box
+		x0, y0 = min(x0, box.GetX()), min(y0, box.GetY())
+		x1, y1 = max(x1, box.GetX()+box.GetWidth()), max(y1, box.GetY()+box.GetHeight())
+	}
+	return &ocr.LayoutBox{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package ocr
+
+import (
+	"backend/gen/ocr"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func testLayoutPages() []LayoutPage {
+	return []LayoutPage{
+		{
+			Number:   0,
+			ImageKey: "tenants/acme/images/01J/page-0.png",
+			Layout: &ocr.PageLayout{
+				Width:  1000,
+				Height: 1400,
+				Lines: []*ocr.LayoutLine{
+					{
+						Box: &ocr.LayoutBox{X: 100, Y: 50, Width: 300, Height: 20},
+						Words: []*ocr.LayoutWord{
+							{Text: "Invoice", Box: &ocr.LayoutBox{X: 100, Y: 50, Width: 120, Height: 20}, Confidence: 0.97},
+							{Text: "<A&B>", Box: &ocr.LayoutBox{X: 240, Y: 50, Width: 160, Height: 20}, Confidence: 0.5},
+						},
+					},
+					{
+						Box: &ocr.LayoutBox{X: 80, Y: 90, Width: 200, Height: 18},
+						Words: []*ocr.LayoutWord{
+							{Text: `"quoted"`, Box: &ocr.LayoutBox{X: 80, Y: 90, Width: 200, Height: 18}, Confidence: 1},
+						},
+					},
+				},
+			},
+		},
+		{
+			Number:   1,
+			ImageKey: "images/01J/page-1.png",
+			Layout:   &ocr.PageLayout{Width: 1000, Height: 1400},
+		},
+	}
+}
+
+// wellFormed reports whether data parses as XML.
+func wellFormed(data []byte) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func TestLayoutWriters(t *testing.T) {
+	tests := []struct {
+		name     string
+		write    func(io.Writer, []LayoutPage) error
+		pages    []LayoutPage
+		contains []string
+		excludes []string
+	}{
+		{
+			name:  "hocr",
+			write: WriteHocr,
+			pages: testLayoutPages(),
+			contains: []string{
+				`<div class="ocr_page" id="page_1" title="image &#34;page-0.png&#34;; bbox 0 0 1000 1400; ppageno 0">`,
+				`<span class="ocr_line" id="line_1_1" title="bbox 100 50 400 70">`,
+				`<span class="ocrx_word" id="word_1_1_1" title="bbox 100 50 220 70; x_wconf 97">Invoice</span> <span`,
+				`x_wconf 50">&lt;A&amp;B&gt;</span>`,
+				`>&#34;quoted&#34;</span>`,
+				`<div class="ocr_page" id="page_2" title="image &#34;page-1.png&#34;; bbox 0 0 1000 1400; ppageno 1">`,
+			},
+			excludes: []string{"tenants/acme"},
+		},
+		{
+			name:     "hocr without pages",
+			write:    WriteHocr,
+			contains: []string{"<body>\n</body>"},
+			excludes: []string{"ocr_page\""},
+		},
+		{
+			name:  "alto",
+			write: WriteAlto,
+			pages: testLayoutPages(),
+			contains: []string{
+				`<Page ID="page_1" PHYSICAL_IMG_NR="1" WIDTH="1000" HEIGHT="1400">`,
+				`<TextBlock ID="block_1" HPOS="80" VPOS="50" WIDTH="320" HEIGHT="58">`,
+				`<TextLine ID="line_1_1" HPOS="100" VPOS="50" WIDTH="300" HEIGHT="20">`,
+				`<String ID="word_1_1_1" CONTENT="Invoice" HPOS="100" VPOS="50" WIDTH="120" HEIGHT="20" WC="0.97"/><SP/>`,
+				`CONTENT="&lt;A&amp;B&gt;"`,
+				`CONTENT="&#34;quoted&#34;"`,
+				"<Page ID=\"page_2\" PHYSICAL_IMG_NR=\"2\" WIDTH=\"1000\" HEIGHT=\"1400\">\n<PrintSpace HPOS=\"0\" VPOS=\"0\" WIDTH=\"1000\" HEIGHT=\"1400\">\n</PrintSpace>",
+			},
+			excludes: []string{`block_2`},
+		},
+		{
+			name:     "alto without pages",
+			write:    WriteAlto,
+			contains: []string{"<Layout>\n</Layout>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.write(&buf, tt.pages); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+
+			if err := wellFormed(buf.Bytes()); err != nil {
+				t.Fatalf("output is not well-formed XML: %v\n%s", err, buf.String())
+			}
+
+			out := buf.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(out, want) {
+					t.Errorf("output is missing %q\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("output contains %q\n%s", unwanted, out)
+				}
+			}
+		})
+	}
+}
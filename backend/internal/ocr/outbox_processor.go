@@ -191,6 +191,42 @@ func (p *OutboxProcessor) event(
 			Id:      event.EventID.Bytes,
 			Payload: data,
 		}, nil
+	case events.FILE_CLASSIFIED_EVENT:
+		data := &ocr.FileClassifiedEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.FileClassifiedEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
+	case events.FILE_PAGE_OCR_FAILED_EVENT:
+		data := &ocr.FilePageOcrFailedEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.FilePageOcrFailedEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
+	case events.FILE_SUMMARIZED_EVENT:
+		data := &ocr.FileSummarizedEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.FileSummarizedEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
+	case events.LLM_BUDGET_EXHAUSTED_EVENT:
+		data := &ocr.LlmBudgetExhaustedEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.LlmBudgetExhaustedEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
 	}
 
 	return nil, fmt.Errorf("unknown outbox event type: %s", event.EventType)
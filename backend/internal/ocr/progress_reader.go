@@ -0,0 +1,43 @@
+package ocr
+
+import (
+	"backend/internal/infrastructure/processing"
+	ocrdb "backend/internal/ocr/db"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ProgressReader reads the OCR progress of files for the storage domain.
+type ProgressReader struct {
+	db *ocrdb.Queries
+}
+
+var _ processing.Reader = (*ProgressReader)(nil)
+
+func NewProgressReader(db *ocrdb.Queries) processing.Reader {
+	return &ProgressReader{
+		db: db,
+	}
+}
+
+// FileProgress implements processing.Reader.
+func (r *ProgressReader) FileProgress(
+	ctx context.Context,
+	tenant string,
+	fileID pgtype.UUID,
+) (processing.Progress, error) {
+	row, err := r.db.GetFileProcessingStatus(ctx, ocrdb.GetFileProcessingStatusParams{
+		FileID:   fileID,
+		TenantID: tenant,
+	})
+	if err != nil {
+		return processing.Progress{}, err
+	}
+
+	return processing.Progress{
+		PageCount:      row.PageCount,
+		ProcessedPages: int32(row.ProcessedPages),
+		FailedPages:    int32(row.FailedPages),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package ocr
+
+import (
+	"backend/internal/infrastructure/processing"
+	ocrdb "backend/internal/ocr/db"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SchemaLookup looks up extraction schemas for the storage domain.
+type SchemaLookup struct {
+	db *ocrdb.Queries
+}
+
+var _ processing.Schemas = (*SchemaLookup)(nil)
+
+func NewSchemaLookup(db *ocrdb.Queries) processing.Schemas {
+	return &SchemaLookup{
+		db: db,
+	}
+}
+
+// SchemaExists implements processing.Schemas.
+func (l *SchemaLookup) SchemaExists(
+	ctx context.Context,
+	tenant string,
+	id pgtype.UUID,
+) (bool, error) {
+	_, err := l.db.GetExtractionSchemaByID(ctx, ocrdb.GetExtractionSchemaByIDParams{
+		ID:       id,
+		TenantID: tenant,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
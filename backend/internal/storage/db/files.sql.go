@@ -12,16 +12,18 @@ import (
 )
 
 const createFile = `-- name: CreateFile :one
-INSERT INTO storage.files (id, file_name, file_size, file_type)
-VALUES ($1, $2, $3, $4)
-RETURNING id, file_name, file_size, file_type, created_at, updated_at
+INSERT INTO storage.files (id, file_name, file_size, file_type, content_hash, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata
 `
 
 type CreateFileParams struct {
-	ID       pgtype.UUID `json:"id"`
-	FileName string      `json:"file_name"`
-	FileSize int64       `json:"file_size"`
-	FileType string      `json:"file_type"`
+	ID          pgtype.UUID `json:"id"`
+	FileName    string      `json:"file_name"`
+	FileSize    int64       `json:"file_size"`
+	FileType    string      `json:"file_type"`
+	ContentHash *string     `json:"content_hash"`
+	TenantID    string      `json:"tenant_id"`
 }
 
 func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (StorageFile, error) {
@@ -30,6 +32,8 @@ func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (Storage
 		arg.FileName,
 		arg.FileSize,
 		arg.FileType,
+		arg.ContentHash,
+		arg.TenantID,
 	)
 	var i StorageFile
 	err := row.Scan(
@@ -39,53 +43,297 @@ func (q *Queries) CreateFile(ctx context.Context, arg CreateFileParams) (Storage
 		&i.FileType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DocumentType,
+		&i.Language,
+		&i.DisplayTitle,
+		&i.ContentHash,
+		&i.TenantID,
+		&i.DeletedAt,
+		&i.FolderID,
+		&i.Tags,
+		&i.Metadata,
 	)
 	return i, err
 }
 
-const deleteFilesByIDs = `-- name: DeleteFilesByIDs :exec
+const countFiles = `-- name: CountFiles :one
+WITH RECURSIVE folders AS (
+    SELECT storage.folders.id FROM storage.folders
+    WHERE storage.folders.id = $1
+      AND storage.folders.tenant_id = $2
+    UNION ALL
+    SELECT f.id FROM storage.folders f
+    JOIN folders ON f.parent_id = folders.id
+    WHERE $3::bool
+)
+SELECT COUNT(*) FROM storage.files files
+WHERE files.tenant_id = $2
+  AND files.deleted_at IS NULL
+  AND ($4::text IS NULL OR files.document_type = $4)
+  AND ($1::uuid IS NULL OR files.folder_id IN (SELECT folders.id FROM folders))
+  AND files.tags @> $5::text[]
+  AND files.metadata @> $6::jsonb
+  AND ($7::text IS NULL
+    OR files.file_name ILIKE '%' || $7 || '%'
+    OR files.display_title ILIKE '%' || $7 || '%')
+  AND ($8::timestamptz IS NULL OR files.created_at >= $8)
+  AND ($9::timestamptz IS NULL OR files.created_at < $9)
+  AND ($10::bigint IS NULL OR files.file_size >= $10)
+  AND ($11::bigint IS NULL OR files.file_size <= $11)
+  AND (cardinality($12::text[]) = 0 OR files.file_type = ANY($12::text[]))
+`
+
+type CountFilesParams struct {
+	FolderID          pgtype.UUID        `json:"folder_id"`
+	TenantID          string             `json:"tenant_id"`
+	IncludeSubfolders bool               `json:"include_subfolders"`
+	DocumentType      *string            `json:"document_type"`
+	Tags              []string           `json:"tags"`
+	Metadata          []byte             `json:"metadata"`
+	Query             *string            `json:"query"`
+	CreatedAfter      pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore     pgtype.Timestamptz `json:"created_before"`
+	MinSize           *int64             `json:"min_size"`
+	MaxSize           *int64             `json:"max_size"`
+	FileTypes         []string           `json:"file_types"`
+}
+
+func (q *Queries) CountFiles(ctx context.Context, arg CountFilesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFiles,
+		arg.FolderID,
+		arg.TenantID,
+		arg.IncludeSubfolders,
+		arg.DocumentType,
+		arg.Tags,
+		arg.Metadata,
+		arg.Query,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.MinSize,
+		arg.MaxSize,
+		arg.FileTypes,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteFilesByIDs = `-- name: DeleteFilesByIDs :many
 DELETE FROM storage.files
 WHERE id = ANY($1::uuid[])
+RETURNING id, tenant_id
 `
 
-func (q *Queries) DeleteFilesByIDs(ctx context.Context, dollar_1 []pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteFilesByIDs, dollar_1)
-	return err
+type DeleteFilesByIDsRow struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID string      `json:"tenant_id"`
 }
 
-const getFiles = `-- name: GetFiles :many
-SELECT 
-    id, file_name, file_size, file_type, created_at, updated_at,
-    COUNT(*) OVER() AS total
-FROM storage.files
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+func (q *Queries) DeleteFilesByIDs(ctx context.Context, ids []pgtype.UUID) ([]DeleteFilesByIDsRow, error) {
+	rows, err := q.db.Query(ctx, deleteFilesByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteFilesByIDsRow
+	for rows.Next() {
+		var i DeleteFilesByIDsRow
+		if err := rows.Scan(&i.ID, &i.TenantID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFile = `-- name: GetFile :one
+SELECT id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata FROM storage.files
+WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
 `
 
-type GetFilesParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type GetFileParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID string      `json:"tenant_id"`
 }
 
-type GetFilesRow struct {
+func (q *Queries) GetFile(ctx context.Context, arg GetFileParams) (StorageFile, error) {
+	row := q.db.QueryRow(ctx, getFile, arg.ID, arg.TenantID)
+	var i StorageFile
+	err := row.Scan(
+		&i.ID,
+		&i.FileName,
+		&i.FileSize,
+		&i.FileType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DocumentType,
+		&i.Language,
+		&i.DisplayTitle,
+		&i.ContentHash,
+		&i.TenantID,
+		&i.DeletedAt,
+		&i.FolderID,
+		&i.Tags,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const getFileKeys = `-- name: GetFileKeys :many
+SELECT id, tenant_id, created_at FROM storage.files
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type GetFileKeysParams struct {
+	After pgtype.UUID `json:"after"`
+	Limit int32       `json:"limit"`
+}
+
+type GetFileKeysRow struct {
 	ID        pgtype.UUID        `json:"id"`
-	FileName  string             `json:"file_name"`
-	FileSize  int64              `json:"file_size"`
-	FileType  string             `json:"file_type"`
+	TenantID  string             `json:"tenant_id"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
-	Total     int64              `json:"total"`
 }
 
-func (q *Queries) GetFiles(ctx context.Context, arg GetFilesParams) ([]GetFilesRow, error) {
-	rows, err := q.db.Query(ctx, getFiles, arg.Limit, arg.Offset)
+func (q *Queries) GetFileKeys(ctx context.Context, arg GetFileKeysParams) ([]GetFileKeysRow, error) {
+	rows, err := q.db.Query(ctx, getFileKeys, arg.After, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetFilesRow
+	var items []GetFileKeysRow
 	for rows.Next() {
-		var i GetFilesRow
+		var i GetFileKeysRow
+		if err := rows.Scan(&i.ID, &i.TenantID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTenantUsage = `-- name: GetTenantUsage :one
+SELECT
+    COUNT(*) AS files,
+    COALESCE(SUM(file_size), 0)::bigint AS bytes
+FROM storage.files
+WHERE tenant_id = $1 AND deleted_at IS NULL
+`
+
+type GetTenantUsageRow struct {
+	Files int64 `json:"files"`
+	Bytes int64 `json:"bytes"`
+}
+
+// Trashed files are left out of the quota, the trash purger removes them
+// after the retention period.
+func (q *Queries) GetTenantUsage(ctx context.Context, tenantID string) (GetTenantUsageRow, error) {
+	row := q.db.QueryRow(ctx, getTenantUsage, tenantID)
+	var i GetTenantUsageRow
+	err := row.Scan(&i.Files, &i.Bytes)
+	return i, err
+}
+
+const getTrashedFiles = `-- name: GetTrashedFiles :many
+SELECT 
+    files.id, files.file_name, files.file_size, files.file_type, files.created_at, files.updated_at, files.document_type, files.language, files.display_title, files.content_hash, files.tenant_id, files.deleted_at, files.folder_id, files.tags, files.metadata,
+    COUNT(*) OVER() AS total
+FROM storage.files files
+WHERE files.tenant_id = $1
+  AND files.deleted_at IS NOT NULL
+ORDER BY files.deleted_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetTrashedFilesParams struct {
+	TenantID string `json:"tenant_id"`
+	Limit    int32  `json:"limit"`
+	Offset   int32  `json:"offset"`
+}
+
+type GetTrashedFilesRow struct {
+	StorageFile StorageFile `json:"storage_file"`
+	Total       int64       `json:"total"`
+}
+
+func (q *Queries) GetTrashedFiles(ctx context.Context, arg GetTrashedFilesParams) ([]GetTrashedFilesRow, error) {
+	rows, err := q.db.Query(ctx, getTrashedFiles,
+		arg.TenantID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrashedFilesRow
+	for rows.Next() {
+		var i GetTrashedFilesRow
+		if err := rows.Scan(
+			&i.StorageFile.ID,
+			&i.StorageFile.FileName,
+			&i.StorageFile.FileSize,
+			&i.StorageFile.FileType,
+			&i.StorageFile.CreatedAt,
+			&i.StorageFile.UpdatedAt,
+			&i.StorageFile.DocumentType,
+			&i.StorageFile.Language,
+			&i.StorageFile.DisplayTitle,
+			&i.StorageFile.ContentHash,
+			&i.StorageFile.TenantID,
+			&i.StorageFile.DeletedAt,
+			&i.StorageFile.FolderID,
+			&i.StorageFile.Tags,
+			&i.StorageFile.Metadata,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveFilesToFolder = `-- name: MoveFilesToFolder :many
+UPDATE storage.files
+SET folder_id = $1,
+    updated_at = NOW()
+WHERE id = ANY($2::uuid[])
+  AND tenant_id = $3
+  AND deleted_at IS NULL
+RETURNING id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata
+`
+
+type MoveFilesToFolderParams struct {
+	FolderID pgtype.UUID   `json:"folder_id"`
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+func (q *Queries) MoveFilesToFolder(ctx context.Context, arg MoveFilesToFolderParams) ([]StorageFile, error) {
+	rows, err := q.db.Query(ctx, moveFilesToFolder,
+		arg.FolderID,
+		arg.Ids,
+		arg.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StorageFile
+	for rows.Next() {
+		var i StorageFile
 		if err := rows.Scan(
 			&i.ID,
 			&i.FileName,
@@ -93,7 +341,381 @@ func (q *Queries) GetFiles(ctx context.Context, arg GetFilesParams) ([]GetFilesR
 			&i.FileType,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.Total,
+			&i.DocumentType,
+			&i.Language,
+			&i.DisplayTitle,
+			&i.ContentHash,
+			&i.TenantID,
+			&i.DeletedAt,
+			&i.FolderID,
+			&i.Tags,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveFolderFilesToRoot = `-- name: MoveFolderFilesToRoot :many
+WITH RECURSIVE folders AS (
+    SELECT storage.folders.id FROM storage.folders
+    WHERE storage.folders.id = $1
+      AND storage.folders.tenant_id = $2
+    UNION ALL
+    SELECT f.id FROM storage.folders f
+    JOIN folders ON f.parent_id = folders.id
+)
+UPDATE storage.files
+SET folder_id = NULL,
+    updated_at = NOW()
+WHERE folder_id IN (SELECT folders.id FROM folders)
+RETURNING id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata
+`
+
+type MoveFolderFilesToRootParams struct {
+	FolderID pgtype.UUID `json:"folder_id"`
+	TenantID string      `json:"tenant_id"`
+}
+
+func (q *Queries) MoveFolderFilesToRoot(ctx context.Context, arg MoveFolderFilesToRootParams) ([]StorageFile, error) {
+	rows, err := q.db.Query(ctx, moveFolderFilesToRoot,
+		arg.FolderID,
+		arg.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StorageFile
+	for rows.Next() {
+		var i StorageFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.FileName,
+			&i.FileSize,
+			&i.FileType,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DocumentType,
+			&i.Language,
+			&i.DisplayTitle,
+			&i.ContentHash,
+			&i.TenantID,
+			&i.DeletedAt,
+			&i.FolderID,
+			&i.Tags,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTrashedFiles = `-- name: PurgeTrashedFiles :many
+DELETE FROM storage.files
+WHERE id IN (
+    SELECT id FROM storage.files
+    WHERE deleted_at < $1
+    ORDER BY deleted_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, tenant_id
+`
+
+type PurgeTrashedFilesParams struct {
+	DeletedBefore pgtype.Timestamptz `json:"deleted_before"`
+	Limit         int32              `json:"limit"`
+}
+
+type PurgeTrashedFilesRow struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID string      `json:"tenant_id"`
+}
+
+func (q *Queries) PurgeTrashedFiles(ctx context.Context, arg PurgeTrashedFilesParams) ([]PurgeTrashedFilesRow, error) {
+	rows, err := q.db.Query(ctx, purgeTrashedFiles, arg.DeletedBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PurgeTrashedFilesRow
+	for rows.Next() {
+		var i PurgeTrashedFilesRow
+		if err := rows.Scan(&i.ID, &i.TenantID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreFilesByIDs = `-- name: RestoreFilesByIDs :many
+UPDATE storage.files
+SET deleted_at = NULL
+WHERE id = ANY($1::uuid[])
+  AND tenant_id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id
+`
+
+type RestoreFilesByIDsParams struct {
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+func (q *Queries) RestoreFilesByIDs(ctx context.Context, arg RestoreFilesByIDsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, restoreFilesByIDs, arg.Ids, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trashFilesByIDs = `-- name: TrashFilesByIDs :many
+UPDATE storage.files
+SET deleted_at = NOW()
+WHERE id = ANY($1::uuid[])
+  AND tenant_id = $2
+  AND deleted_at IS NULL
+RETURNING id
+`
+
+type TrashFilesByIDsParams struct {
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+func (q *Queries) TrashFilesByIDs(ctx context.Context, arg TrashFilesByIDsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, trashFilesByIDs, arg.Ids, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateFile = `-- name: UpdateFile :one
+UPDATE storage.files
+SET file_name = COALESCE($1, file_name),
+    display_title = COALESCE($2, display_title),
+    document_type = COALESCE($3, document_type),
+    language = COALESCE($4, language),
+    updated_at = NOW()
+WHERE id = $5
+  AND tenant_id = $6
+  AND deleted_at IS NULL
+RETURNING id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata
+`
+
+type UpdateFileParams struct {
+	FileName     *string     `json:"file_name"`
+	DisplayTitle *string     `json:"display_title"`
+	DocumentType *string     `json:"document_type"`
+	Language     *string     `json:"language"`
+	ID           pgtype.UUID `json:"id"`
+	TenantID     string      `json:"tenant_id"`
+}
+
+func (q *Queries) UpdateFile(ctx context.Context, arg UpdateFileParams) (StorageFile, error) {
+	row := q.db.QueryRow(ctx, updateFile,
+		arg.FileName,
+		arg.DisplayTitle,
+		arg.DocumentType,
+		arg.Language,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i StorageFile
+	err := row.Scan(
+		&i.ID,
+		&i.FileName,
+		&i.FileSize,
+		&i.FileType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DocumentType,
+		&i.Language,
+		&i.DisplayTitle,
+		&i.ContentHash,
+		&i.TenantID,
+		&i.DeletedAt,
+		&i.FolderID,
+		&i.Tags,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const updateFileClassification = `-- name: UpdateFileClassification :exec
+UPDATE storage.files
+SET document_type = $2,
+    language = $3,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateFileClassificationParams struct {
+	ID           pgtype.UUID `json:"id"`
+	DocumentType *string     `json:"document_type"`
+	Language     *string     `json:"language"`
+}
+
+func (q *Queries) UpdateFileClassification(ctx context.Context, arg UpdateFileClassificationParams) error {
+	_, err := q.db.Exec(ctx, updateFileClassification, arg.ID, arg.DocumentType, arg.Language)
+	return err
+}
+
+const updateFileDisplayTitle = `-- name: UpdateFileDisplayTitle :exec
+UPDATE storage.files
+SET display_title = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateFileDisplayTitleParams struct {
+	ID           pgtype.UUID `json:"id"`
+	DisplayTitle *string     `json:"display_title"`
+}
+
+func (q *Queries) UpdateFileDisplayTitle(ctx context.Context, arg UpdateFileDisplayTitleParams) error {
+	_, err := q.db.Exec(ctx, updateFileDisplayTitle, arg.ID, arg.DisplayTitle)
+	return err
+}
+
+const updateFileMetadata = `-- name: UpdateFileMetadata :one
+UPDATE storage.files
+SET metadata = (metadata || $1::jsonb) - $2::text[],
+    updated_at = NOW()
+WHERE id = $3
+  AND tenant_id = $4
+  AND deleted_at IS NULL
+RETURNING id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata
+`
+
+type UpdateFileMetadataParams struct {
+	Set      []byte      `json:"set"`
+	Remove   []string    `json:"remove"`
+	ID       pgtype.UUID `json:"id"`
+	TenantID string      `json:"tenant_id"`
+}
+
+func (q *Queries) UpdateFileMetadata(ctx context.Context, arg UpdateFileMetadataParams) (StorageFile, error) {
+	row := q.db.QueryRow(ctx, updateFileMetadata,
+		arg.Set,
+		arg.Remove,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i StorageFile
+	err := row.Scan(
+		&i.ID,
+		&i.FileName,
+		&i.FileSize,
+		&i.FileType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DocumentType,
+		&i.Language,
+		&i.DisplayTitle,
+		&i.ContentHash,
+		&i.TenantID,
+		&i.DeletedAt,
+		&i.FolderID,
+		&i.Tags,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const updateFileTags = `-- name: UpdateFileTags :many
+UPDATE storage.files
+SET tags = ARRAY(
+        SELECT DISTINCT tag
+        FROM unnest(tags || $1::text[]) AS tag
+        WHERE tag <> ALL($2::text[])
+        ORDER BY tag
+    ),
+    updated_at = NOW()
+WHERE id = ANY($3::uuid[])
+  AND tenant_id = $4
+  AND deleted_at IS NULL
+RETURNING id, file_name, file_size, file_type, created_at, updated_at, document_type, language, display_title, content_hash, tenant_id, deleted_at, folder_id, tags, metadata
+`
+
+type UpdateFileTagsParams struct {
+	Add      []string      `json:"add"`
+	Remove   []string      `json:"remove"`
+	Ids      []pgtype.UUID `json:"ids"`
+	TenantID string        `json:"tenant_id"`
+}
+
+func (q *Queries) UpdateFileTags(ctx context.Context, arg UpdateFileTagsParams) ([]StorageFile, error) {
+	rows, err := q.db.Query(ctx, updateFileTags,
+		arg.Add,
+		arg.Remove,
+		arg.Ids,
+		arg.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StorageFile
+	for rows.Next() {
+		var i StorageFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.FileName,
+			&i.FileSize,
+			&i.FileType,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DocumentType,
+			&i.Language,
+			&i.DisplayTitle,
+			&i.ContentHash,
+			&i.TenantID,
+			&i.DeletedAt,
+			&i.FolderID,
+			&i.Tags,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
@@ -9,10 +9,28 @@ import (
 )
 
 type StorageFile struct {
+	ID           pgtype.UUID        `json:"id"`
+	FileName     string             `json:"file_name"`
+	FileSize     int64              `json:"file_size"`
+	FileType     string             `json:"file_type"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	DocumentType *string            `json:"document_type"`
+	Language     *string            `json:"language"`
+	DisplayTitle *string            `json:"display_title"`
+	ContentHash  *string            `json:"content_hash"`
+	TenantID     string             `json:"tenant_id"`
+	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
+	FolderID     pgtype.UUID        `json:"folder_id"`
+	Tags         []string           `json:"tags"`
+	Metadata     []byte             `json:"metadata"`
+}
+
+type StorageFolder struct {
 	ID        pgtype.UUID        `json:"id"`
-	FileName  string             `json:"file_name"`
-	FileSize  int64              `json:"file_size"`
-	FileType  string             `json:"file_type"`
+	TenantID  string             `json:"tenant_id"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Name      string             `json:"name"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
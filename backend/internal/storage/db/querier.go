@@ -11,12 +11,34 @@ import (
 )
 
 type Querier interface {
+	CountFiles(ctx context.Context, arg CountFilesParams) (int64, error)
 	CreateFile(ctx context.Context, arg CreateFileParams) (StorageFile, error)
+	CreateFolder(ctx context.Context, arg CreateFolderParams) (StorageFolder, error)
 	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
-	DeleteFilesByIDs(ctx context.Context, dollar_1 []pgtype.UUID) error
-	GetFiles(ctx context.Context, arg GetFilesParams) ([]GetFilesRow, error)
+	DeleteFilesByIDs(ctx context.Context, ids []pgtype.UUID) ([]DeleteFilesByIDsRow, error)
+	DeleteFolder(ctx context.Context, arg DeleteFolderParams) (int64, error)
+	FolderHasAncestor(ctx context.Context, arg FolderHasAncestorParams) (bool, error)
+	GetFile(ctx context.Context, arg GetFileParams) (StorageFile, error)
+	GetFileKeys(ctx context.Context, arg GetFileKeysParams) ([]GetFileKeysRow, error)
+	GetFolder(ctx context.Context, arg GetFolderParams) (StorageFolder, error)
+	GetFolders(ctx context.Context, arg GetFoldersParams) ([]StorageFolder, error)
 	GetOutboxUnpublishedEvents(ctx context.Context, limit int32) ([]GetOutboxUnpublishedEventsRow, error)
+	// Trashed files are left out of the quota, the trash purger removes them
+	// after the retention period.
+	GetTenantUsage(ctx context.Context, tenantID string) (GetTenantUsageRow, error)
+	GetTrashedFiles(ctx context.Context, arg GetTrashedFilesParams) ([]GetTrashedFilesRow, error)
 	MarkEventAsPublished(ctx context.Context, eventID pgtype.UUID) error
+	MoveFilesToFolder(ctx context.Context, arg MoveFilesToFolderParams) ([]StorageFile, error)
+	MoveFolderFilesToRoot(ctx context.Context, arg MoveFolderFilesToRootParams) ([]StorageFile, error)
+	PurgeTrashedFiles(ctx context.Context, arg PurgeTrashedFilesParams) ([]PurgeTrashedFilesRow, error)
+	RestoreFilesByIDs(ctx context.Context, arg RestoreFilesByIDsParams) ([]pgtype.UUID, error)
+	TrashFilesByIDs(ctx context.Context, arg TrashFilesByIDsParams) ([]pgtype.UUID, error)
+	UpdateFile(ctx context.Context, arg UpdateFileParams) (StorageFile, error)
+	UpdateFileClassification(ctx context.Context, arg UpdateFileClassificationParams) error
+	UpdateFileDisplayTitle(ctx context.Context, arg UpdateFileDisplayTitleParams) error
+	UpdateFileMetadata(ctx context.Context, arg UpdateFileMetadataParams) (StorageFile, error)
+	UpdateFileTags(ctx context.Context, arg UpdateFileTagsParams) ([]StorageFile, error)
+	UpdateFolder(ctx context.Context, arg UpdateFolderParams) (StorageFolder, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,7 +1,10 @@
 package events
 
 const (
-	STORAGE_CHANNEL             string = "storage"
-	STORAGE_FILE_UPLOADED_EVENT string = "storage.file.uploaded"
-	STORAGE_FILES_DELETED_EVENT string = "storage.files.deleted"
+	STORAGE_CHANNEL              string = "storage"
+	STORAGE_FILE_UPLOADED_EVENT  string = "storage.file.uploaded"
+	STORAGE_FILES_DELETED_EVENT  string = "storage.files.deleted"
+	STORAGE_FILES_TRASHED_EVENT  string = "storage.files.trashed"
+	STORAGE_FILES_RESTORED_EVENT string = "storage.files.restored"
+	STORAGE_FILE_UPDATED_EVENT   string = "storage.file.updated"
 )
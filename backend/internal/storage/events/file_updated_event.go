@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/storage"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type FileUpdatedEvent struct {
+	Id      ulid.ULID
+	Payload *storage.FileUpdatedEventData
+}
+
+var _ core.EventSpec = (*FileUpdatedEvent)(nil)
+
+func NewFileUpdatedEvent(
+	payload *storage.FileUpdatedEventData,
+) *FileUpdatedEvent {
+	return &FileUpdatedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewFileUpdatedEventFromMessage(
+	msg jetstream.Msg,
+) (*FileUpdatedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &storage.FileUpdatedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &FileUpdatedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *FileUpdatedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *FileUpdatedEvent) Type() string {
+	return STORAGE_FILE_UPDATED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *FileUpdatedEvent) Data() proto.Message {
+	return ev.Payload
+}
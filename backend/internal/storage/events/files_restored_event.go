@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/storage"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type FilesRestoredEvent struct {
+	Id      ulid.ULID
+	Payload *storage.FilesRestoredEventData
+}
+
+var _ core.EventSpec = (*FilesRestoredEvent)(nil)
+
+func NewFilesRestoredEvent(
+	payload *storage.FilesRestoredEventData,
+) *FilesRestoredEvent {
+	return &FilesRestoredEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewFilesRestoredEventFromMessage(
+	msg jetstream.Msg,
+) (*FilesRestoredEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &storage.FilesRestoredEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &FilesRestoredEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *FilesRestoredEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *FilesRestoredEvent) Type() string {
+	return STORAGE_FILES_RESTORED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *FilesRestoredEvent) Data() proto.Message {
+	return ev.Payload
+}
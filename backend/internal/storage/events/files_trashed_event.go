@@ -0,0 +1,65 @@
+package events
+
+import (
+	"backend/gen/storage"
+	"backend/internal/core"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+type FilesTrashedEvent struct {
+	Id      ulid.ULID
+	Payload *storage.FilesTrashedEventData
+}
+
+var _ core.EventSpec = (*FilesTrashedEvent)(nil)
+
+func NewFilesTrashedEvent(
+	payload *storage.FilesTrashedEventData,
+) *FilesTrashedEvent {
+	return &FilesTrashedEvent{
+		Id:      core.NewEventID(),
+		Payload: payload,
+	}
+}
+
+func NewFilesTrashedEventFromMessage(
+	msg jetstream.Msg,
+) (*FilesTrashedEvent, error) {
+	headers := msg.Headers()
+	data := msg.Data()
+
+	payload := &storage.FilesTrashedEventData{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	id, err := ulid.Parse(headers.Get(core.EVENT_ID_HEADER))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &FilesTrashedEvent{
+		Id:      id,
+		Payload: payload,
+	}
+
+	return event, nil
+}
+
+// ID implements core.EventSpec.
+func (ev *FilesTrashedEvent) ID() string {
+	return ev.Id.String()
+}
+
+// Type implements core.EventSpec.
+func (ev *FilesTrashedEvent) Type() string {
+	return STORAGE_FILES_TRASHED_EVENT
+}
+
+// Data implements core.EventSpec.
+func (ev *FilesTrashedEvent) Data() proto.Message {
+	return ev.Payload
+}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"backend/internal/infrastructure/nats"
+	"backend/internal/ocr/events"
+	storagedb "backend/internal/storage/db"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
+)
+
+type FileClassifiedConsumer struct {
+	*nats.NatsConsumer[*events.FileClassifiedEvent]
+	db *storagedb.Queries
+}
+
+func NewFileClassifiedConsumer(
+	js jetstream.JetStream,
+	db *storagedb.Queries,
+) *FileClassifiedConsumer {
+	name := "storage_file_classified_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FileClassifiedConsumer{
+		db: db,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_CLASSIFIED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFileClassifiedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "Storage File Classified Event Consumer",
+			FilterSubject: events.FILE_CLASSIFIED_EVENT,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FileClassifiedConsumer) handler(
+	ctx context.Context,
+	event *events.FileClassifiedEvent,
+) error {
+	tracer := otel.Tracer("storage_file_classified_consumer")
+	ctx, span := tracer.Start(
+		ctx,
+		"FileClassifiedConsumer.handler",
+	)
+	defer span.End()
+
+	id, err := ulid.Parse(event.Payload.FileKey)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	err = c.db.UpdateFileClassification(ctx, storagedb.UpdateFileClassificationParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		DocumentType: &event.Payload.DocumentType,
+		Language:     &event.Payload.Language,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
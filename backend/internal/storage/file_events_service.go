@@ -0,0 +1,277 @@
+package storage
+
+import (
+	"backend/gen/storage"
+	"backend/internal/core"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/nats"
+	"backend/internal/infrastructure/service"
+	ocrev "backend/internal/ocr/events"
+	storagedb "backend/internal/storage/db"
+	"backend/internal/storage/events"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const fileEventsPath = "/storage/files/{file_key}/events"
+
+// Comments are sent on idle streams so proxies do not close them
+const fileEventsKeepAlive = 15 * time.Second
+
+type fileEventBuilder func(msg jetstream.Msg) (core.EventSpec, error)
+
+// fileEventSources are the streamed events by channel, keyed by subject.
+var fileEventSources = map[string]map[string]fileEventBuilder{
+	events.STORAGE_CHANNEL: {
+		events.STORAGE_FILE_UPLOADED_EVENT: func(msg jetstream.Msg) (core.EventSpec, error) {
+			return events.NewFileUploadedEventFromMessage(msg)
+		},
+		events.STORAGE_FILES_DELETED_EVENT: func(msg jetstream.Msg) (core.EventSpec, error) {
+			return events.NewFilesDeletedEventFromMessage(msg)
+		},
+	},
+	ocrev.OCR_CHANNEL: {
+		ocrev.FILE_PAGE_RENDERED_EVENT: func(msg jetstream.Msg) (core.EventSpec, error) {
+			return ocrev.NewFilePageRenderedEventFromMessage(msg)
+		},
+		ocrev.FILE_PAGE_OCR_GENERATED_EVENT: func(msg jetstream.Msg) (core.EventSpec, error) {
+			return ocrev.NewFilePageOcrGeneratedEventFromMessage(msg)
+		},
+		ocrev.FILE_PAGE_OCR_FAILED_EVENT: func(msg jetstream.Msg) (core.EventSpec, error) {
+			return ocrev.NewFilePageOcrFailedEventFromMessage(msg)
+		},
+		ocrev.FILE_PAGES_DELETED_EVENT: func(msg jetstream.Msg) (core.EventSpec, error) {
+			return ocrev.NewFilePagesDeletedEventFromMessage(msg)
+		},
+	},
+}
+
+// FileEventsService streams the processing events of a file to the browser
+// as Server-Sent Events, through an ephemeral consumer on every channel.
+type FileEventsService struct {
+	js   jetstream.JetStream
+	db   *storagedb.Queries
+	auth *auth.Middleware
+}
+
+var _ service.Service = (*FileEventsService)(nil)
+
+func NewFileEventsService(
+	js jetstream.JetStream,
+	db *storagedb.Queries,
+	middleware *auth.Middleware,
+) *FileEventsService {
+	return &FileEventsService{
+		js:   js,
+		db:   db,
+		auth: middleware,
+	}
+}
+
+// Register implements service.Service.
+func (s *FileEventsService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	s.auth.Require(http.MethodGet, fileEventsPath, auth.ScopeRead)
+
+	err := mux.HandlePath(http.MethodGet, fileEventsPath, func(
+		w http.ResponseWriter,
+		r *http.Request,
+		pathParams map[string]string,
+	) {
+		if err := s.stream(w, r, pathParams["file_key"]); err != nil {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+		}
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to register file events", "error", err)
+	}
+}
+
+// stream writes the events of the file until the client disconnects or the
+// file is deleted. Errors are only returned before the stream starts.
+func (s *FileEventsService) stream(
+	w http.ResponseWriter,
+	r *http.Request,
+	fileKey string,
+) error {
+	ctx := r.Context()
+	tenant := auth.Tenant(ctx)
+
+	id, ok := parseFileKey(fileKey)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid file key")
+	}
+
+	_, err := s.db.GetFile(ctx, storagedb.GetFileParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: tenant,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "file not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	messages := make(chan jetstream.Msg, 64)
+	for channel, builders := range fileEventSources {
+		consumer, err := s.js.OrderedConsumer(ctx, nats.StreamName(channel), jetstream.OrderedConsumerConfig{
+			FilterSubjects: lo.Keys(builders),
+			DeliverPolicy:  jetstream.DeliverNewPolicy,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create consumer: %w", err)
+		}
+
+		consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+			select {
+			case messages <- msg:
+			case <-ctx.Done():
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed to consume %s: %w", channel, err)
+		}
+		defer consumeCtx.Stop()
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		slog.WarnContext(ctx, "Failed to flush file events", "error", err)
+		return nil
+	}
+
+	ticker := time.NewTicker(fileEventsKeepAlive)
+	defer ticker.Stop()
+
+	for deleted := false; !deleted; {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		case msg := <-messages:
+			build := fileEventSources[channelOf(msg.Subject())][msg.Subject()]
+			if build == nil {
+				continue
+			}
+			event, err := build(msg)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to build file event", "subject", msg.Subject(), "error", err)
+				continue
+			}
+
+			// Only keys and page numbers are sent, payloads may carry page text
+			var data *storage.FileEvent
+			data, deleted = fileEvent(event, id, tenant)
+			if data == nil {
+				continue
+			}
+			data.FileKey = fileKey
+
+			payload, err := protojson.Marshal(data)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to marshal file event", "event_id", event.ID(), "error", err)
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID(), event.Type(), payload); err != nil {
+				return nil
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// fileEvent returns the data streamed for event when it concerns the file of
+// the tenant, and whether it deletes the file.
+func fileEvent(event core.EventSpec, id uuid.UUID, tenant string) (*storage.FileEvent, bool) {
+	var eventTenant string
+	var fileKeys []string
+	var deleted bool
+	data := &storage.FileEvent{}
+
+	switch event := event.(type) {
+	case *events.FileUploadedEvent:
+		eventTenant, fileKeys = event.Payload.TenantId, []string{event.Payload.FileKey}
+	case *events.FilesDeletedEvent:
+		eventTenant, fileKeys, deleted = event.Payload.TenantId, event.Payload.FileKeys, true
+	case *ocrev.FilePageRenderedEvent:
+		eventTenant, fileKeys = event.Payload.TenantId, []string{event.Payload.FileKey}
+		data.PageNumber, data.PageCount = event.Payload.PageNumber+1, event.Payload.PageCount
+	case *ocrev.FilePageOcrGeneratedEvent:
+		eventTenant, fileKeys = event.Payload.TenantId, []string{event.Payload.FileId}
+		data.PageNumber, data.PageCount = event.Payload.PageNumber+1, event.Payload.PageCount
+	case *ocrev.FilePageOcrFailedEvent:
+		eventTenant, fileKeys = event.Payload.TenantId, []string{event.Payload.FileId}
+		data.PageNumber, data.PageCount = event.Payload.PageNumber+1, event.Payload.PageCount
+	case *ocrev.FilePagesDeletedEvent:
+		eventTenant, fileKeys, deleted = event.Payload.TenantId, event.Payload.FileKeys, true
+	default:
+		return nil, false
+	}
+
+	if auth.EventTenant(eventTenant) != tenant {
+		return nil, false
+	}
+
+	matched := slices.ContainsFunc(fileKeys, func(key string) bool {
+		keyID, ok := parseFileKey(key)
+		return ok && keyID == id
+	})
+	if !matched {
+		return nil, false
+	}
+
+	return data, deleted
+}
+
+// parseFileKey parses a file key, which events carry either as a ULID or as
+// a UUID.
+func parseFileKey(key string) (uuid.UUID, bool) {
+	if id, err := ulid.ParseStrict(key); err == nil {
+		return uuid.UUID(id), true
+	}
+	if id, err := uuid.Parse(key); err == nil {
+		return id, true
+	}
+	return uuid.UUID{}, false
+}
+
+// channelOf returns the channel of an event subject, its first token.
+func channelOf(subject string) string {
+	channel, _, _ := strings.Cut(subject, ".")
+	return channel
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"backend/internal/infrastructure/nats"
+	"backend/internal/ocr/events"
+	storagedb "backend/internal/storage/db"
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/oklog/ulid/v2"
+)
+
+type FileSummarizedConsumer struct {
+	*nats.NatsConsumer[*events.FileSummarizedEvent]
+	db *storagedb.Queries
+}
+
+func NewFileSummarizedConsumer(
+	js jetstream.JetStream,
+	db *storagedb.Queries,
+) *FileSummarizedConsumer {
+	name := "storage_file_summarized_consumer"
+	numWorkers := 4
+	workerBufferSize := 20
+
+	consumer := &FileSummarizedConsumer{
+		db: db,
+	}
+
+	consumer.NatsConsumer = nats.NewNatsConsumer(
+		name,
+		events.OCR_CHANNEL,
+		events.FILE_SUMMARIZED_EVENT,
+		numWorkers,
+		workerBufferSize,
+		events.NewFileSummarizedEventFromMessage,
+		consumer.handler,
+		js,
+		jetstream.ConsumerConfig{
+			Name:          name,
+			Durable:       name,
+			Description:   "Storage File Summarized Event Consumer",
+			FilterSubject: events.FILE_SUMMARIZED_EVENT,
+		},
+	)
+
+	return consumer
+}
+
+func (c *FileSummarizedConsumer) handler(
+	ctx context.Context,
+	event *events.FileSummarizedEvent,
+) error {
+	// An empty title would clear the display title
+	if strings.TrimSpace(event.Payload.Title) == "" {
+		return nil
+	}
+
+	id, err := ulid.Parse(event.Payload.FileKey)
+	if err != nil {
+		return err
+	}
+
+	return c.db.UpdateFileDisplayTitle(ctx, storagedb.UpdateFileDisplayTitleParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		DisplayTitle: &event.Payload.Title,
+	})
+}
@@ -1,11 +1,15 @@
 package storage
 
 import (
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/nats"
-	"backend/internal/infrastructure/storage"
+	stg "backend/internal/infrastructure/storage"
 	storagedb "backend/internal/storage/db"
 	"backend/internal/storage/events"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -58,39 +62,46 @@ func (c *FileUploadedConsumer) handler(
 	ctx context.Context,
 	event *events.FileUploadedEvent,
 ) error {
-	// Get file info
-	head, err := c.s3.HeadObject(ctx, &s3.HeadObjectInput{
-		Bucket: aws.String(storage.BUCKET_NAME),
-		Key:    aws.String(event.Payload.FileKey),
-	})
+	tenant := auth.EventTenant(event.Payload.TenantId)
 
+	// Get the file and hash its content
+	result, err := c.s3.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(stg.BUCKET_NAME),
+		Key:    aws.String(stg.TenantKey(tenant, event.Payload.FileKey)),
+	})
 	if err != nil {
 		return err
 	}
+	defer result.Body.Close()
 
-	var size int64 = 0
-	if head.ContentLength != nil {
-		size = int64(*head.ContentLength)
+	hash := sha256.New()
+	size, err := io.Copy(hash, result.Body)
+	if err != nil {
+		return err
 	}
+	contentHash := hex.EncodeToString(hash.Sum(nil))
+
 	fileType := ""
-	if head.ContentType != nil {
-		fileType = *head.ContentType
+	if result.ContentType != nil {
+		fileType = *result.ContentType
 	}
 
-	// Create file record
 	id, err := ulid.Parse(event.Payload.FileKey)
 	if err != nil {
 		return err
 	}
 
+	// Create file record
 	if _, err := c.db.CreateFile(ctx, storagedb.CreateFileParams{
 		ID: pgtype.UUID{
 			Bytes: id,
 			Valid: true,
 		},
-		FileName: event.Payload.FileName,
-		FileSize: size,
-		FileType: fileType,
+		FileName:    event.Payload.FileName,
+		FileSize:    size,
+		FileType:    fileType,
+		ContentHash: &contentHash,
+		TenantID:    tenant,
 	}); err != nil {
 		return err
 	}
@@ -1,10 +1,14 @@
 package storage
 
 import (
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/nats"
 	"backend/internal/infrastructure/storage"
 	"backend/internal/storage/events"
 	"context"
+	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -15,6 +19,10 @@ import (
 	"github.com/samber/lo"
 )
 
+// deleteObjectsAttempts is the number of times a batch of source objects is
+// deleted before the event is handed back for redelivery.
+const deleteObjectsAttempts = 3
+
 type FilesDeletedConsumer struct {
 	*nats.NatsConsumer[*events.FilesDeletedEvent]
 	s3 *s3.Client
@@ -69,22 +77,77 @@ func (c *FilesDeletedConsumer) handler(
 		return key == ""
 	})
 
-	// Build the list of objects to delete
+	tenant := auth.EventTenant(event.Payload.TenantId)
+
+	// Build the list of source objects to delete
 	objects := make([]types.ObjectIdentifier, len(fileKeys))
 	for i, fileKey := range fileKeys {
 		objects[i] = types.ObjectIdentifier{
-			Key: aws.String(fileKey),
+			Key: aws.String(storage.TenantKey(tenant, fileKey)),
+		}
+	}
+
+	// Retry the objects that failed, the event is redelivered if some are
+	// still left
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		failed, err := c.deleteObjects(ctx, objects)
+		if err == nil {
+			return nil
+		}
+		if attempt == deleteObjectsAttempts {
+			return err
+		}
+
+		slog.WarnContext(ctx, "Failed to delete source objects, retrying",
+			"attempt", attempt,
+			"failed", len(failed),
+			"error", err,
+		)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+		backoff *= 2
+		objects = failed
 	}
+}
 
-	// Delete multiple files from S3 in a single call
-	_, err := c.s3.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+// deleteObjects deletes multiple objects from S3 in a single call and
+// returns the objects that could not be deleted.
+func (c *FilesDeletedConsumer) deleteObjects(
+	ctx context.Context,
+	objects []types.ObjectIdentifier,
+) ([]types.ObjectIdentifier, error) {
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	result, err := c.s3.DeleteObjects(ctx, &s3.DeleteObjectsInput{
 		Bucket: aws.String(storage.BUCKET_NAME),
 		Delete: &types.Delete{
 			Objects: objects,
 			Quiet:   aws.Bool(true),
 		},
 	})
+	if err != nil {
+		return objects, err
+	}
+	if len(result.Errors) == 0 {
+		return nil, nil
+	}
+
+	failed := lo.Map(result.Errors, func(e types.Error, _ int) types.ObjectIdentifier {
+		return types.ObjectIdentifier{Key: e.Key}
+	})
 
-	return err
+	first := result.Errors[0]
+	return failed, fmt.Errorf(
+		"failed to delete %d objects: %s: %s",
+		len(failed),
+		aws.ToString(first.Key),
+		aws.ToString(first.Message),
+	)
 }
@@ -0,0 +1,172 @@
+package storage
+
+import (
+	storagedb "backend/internal/storage/db"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// getFilesQuery holds the filters of getFiles, which is written by hand as
+// sqlc cannot vary the ORDER BY and a CASE per sort field and direction keeps
+// Postgres from using the sort indexes.
+const getFilesQuery = `WITH RECURSIVE folders AS (
+    SELECT storage.folders.id FROM storage.folders
+    WHERE storage.folders.id = $1
+      AND storage.folders.tenant_id = $2
+    UNION ALL
+    SELECT f.id FROM storage.folders f
+    JOIN folders ON f.parent_id = folders.id
+    WHERE $3::bool
+)
+SELECT files.id, files.file_name, files.file_size, files.file_type, files.created_at, files.updated_at, files.document_type, files.language, files.display_title, files.content_hash, files.tenant_id, files.deleted_at, files.folder_id, files.tags, files.metadata FROM storage.files files
+WHERE files.tenant_id = $2
+  AND files.deleted_at IS NULL
+  AND ($4::text IS NULL OR files.document_type = $4)
+  AND ($1::uuid IS NULL OR files.folder_id IN (SELECT folders.id FROM folders))
+  AND files.tags @> $5::text[]
+  AND files.metadata @> $6::jsonb
+  AND ($7::text IS NULL
+    OR files.file_name ILIKE '%' || $7 || '%'
+    OR files.display_title ILIKE '%' || $7 || '%')
+  AND ($8::timestamptz IS NULL OR files.created_at >= $8)
+  AND ($9::timestamptz IS NULL OR files.created_at < $9)
+  AND ($10::bigint IS NULL OR files.file_size >= $10)
+  AND ($11::bigint IS NULL OR files.file_size <= $11)
+  AND (cardinality($12::text[]) = 0 OR files.file_type = ANY($12::text[]))
+`
+
+// fileSortColumns are the columns files can be sorted by and the type of
+// their cursor, each backed by a (tenant_id, column, id) index.
+var fileSortColumns = map[string]fileSortColumn{
+	"created_at": {column: "files.created_at", cursorType: "timestamptz"},
+	"file_name":  {column: "files.file_name", cursorType: "text"},
+	"file_size":  {column: "files.file_size", cursorType: "bigint"},
+}
+
+type fileSortColumn struct {
+	column     string
+	cursorType string
+}
+
+type getFilesParams struct {
+	FolderID          pgtype.UUID
+	TenantID          string
+	IncludeSubfolders bool
+	DocumentType      *string
+	Tags              []string
+	Metadata          []byte
+	Query             *string
+	CreatedAfter      pgtype.Timestamptz
+	CreatedBefore     pgtype.Timestamptz
+	MinSize           *int64
+	MaxSize           *int64
+	FileTypes         []string
+	CursorID          pgtype.UUID
+	SortBy            string
+	SortDesc          bool
+	CursorCreatedAt   pgtype.Timestamptz
+	CursorFileName    *string
+	CursorFileSize    *int64
+	Limit             int32
+	Offset            int32
+}
+
+// getFiles lists the files matching arg in its sort order, continuing after
+// the cursor when one is set.
+func getFiles(ctx context.Context, db storagedb.DBTX, arg getFilesParams) ([]storagedb.StorageFile, error) {
+	sort, ok := fileSortColumns[arg.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field: %s", arg.SortBy)
+	}
+
+	args := []any{
+		arg.FolderID,
+		arg.TenantID,
+		arg.IncludeSubfolders,
+		arg.DocumentType,
+		arg.Tags,
+		arg.Metadata,
+		arg.Query,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.MinSize,
+		arg.MaxSize,
+		arg.FileTypes,
+	}
+
+	direction, compare := "ASC", ">"
+	if arg.SortDesc {
+		direction, compare = "DESC", "<"
+	}
+
+	// Keyset on the sort field, ties are broken by id
+	query := getFilesQuery
+	if arg.CursorID.Valid {
+		var value any
+		switch arg.SortBy {
+		case "created_at":
+			value = arg.CursorCreatedAt
+		case "file_name":
+			value = arg.CursorFileName
+		case "file_size":
+			value = arg.CursorFileSize
+		}
+		args = append(args, value, arg.CursorID)
+		query += fmt.Sprintf(
+			"  AND (%s, files.id) %s ($%d::%s, $%d::uuid)\n",
+			sort.column, compare, len(args)-1, sort.cursorType, len(args),
+		)
+	}
+
+	args = append(args, arg.Limit, arg.Offset)
+	query += fmt.Sprintf(
+		"ORDER BY %s %s, files.id %s\nLIMIT $%d OFFSET $%d\n",
+		sort.column, direction, direction, len(args)-1, len(args),
+	)
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []storagedb.StorageFile
+	for rows.Next() {
+		file, err := scanFile(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, file)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// scanFile scans a row selecting every column of storage.files in table
+// order, as sqlc generates for StorageFile.
+func scanFile(row pgx.Row) (storagedb.StorageFile, error) {
+	var i storagedb.StorageFile
+	err := row.Scan(
+		&i.ID,
+		&i.FileName,
+		&i.FileSize,
+		&i.FileType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DocumentType,
+		&i.Language,
+		&i.DisplayTitle,
+		&i.ContentHash,
+		&i.TenantID,
+		&i.DeletedAt,
+		&i.FolderID,
+		&i.Tags,
+		&i.Metadata,
+	)
+	return i, err
+}
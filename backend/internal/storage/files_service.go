@@ -3,38 +3,64 @@ package storage
 import (
 	"backend/gen/core"
 	"backend/gen/storage"
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/keyset"
+	"backend/internal/infrastructure/processing"
 	"backend/internal/infrastructure/service"
+	stg "backend/internal/infrastructure/storage"
 	storagedb "backend/internal/storage/db"
-	"backend/internal/storage/events"
 	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
-	"google.golang.org/protobuf/encoding/protojson"
-
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
 	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type FilesService struct {
 	storage.UnimplementedFilesServiceServer
-	db   *storagedb.Queries
-	pool *pgxpool.Pool
+	db       *storagedb.Queries
+	pool     *pgxpool.Pool
+	progress processing.Reader
+	presign  *s3.PresignClient
+	audit    audit.Recorder
+	redact   bool
 }
 
 var _ storage.FilesServiceServer = (*FilesService)(nil)
 var _ service.Service = (*FilesService)(nil)
 
 func NewFilesService(
+	cfg *config.AppConfig,
 	db *storagedb.Queries,
 	pool *pgxpool.Pool,
+	progress processing.Reader,
+	presign *s3.PresignClient,
+	recorder audit.Recorder,
 ) *FilesService {
 	return &FilesService{
-		db:   db,
-		pool: pool,
+		db:       db,
+		pool:     pool,
+		progress: progress,
+		presign:  presign,
+		audit:    recorder,
+		redact:   cfg.Redaction.Enabled,
 	}
 }
 
@@ -48,24 +74,338 @@ func (f *FilesService) GetFiles(
 		limit = 10
 	}
 	offset := max(limit*(req.PageNumber-1), 0)
-	result, err := f.db.GetFiles(ctx, storagedb.GetFilesParams{
-		Limit:  limit,
-		Offset: offset,
-	})
 
+	var documentType *string
+	if req.DocumentType != "" {
+		documentType = &req.DocumentType
+	}
+
+	folderID, err := parseFolderID(req.FolderId)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := marshalMetadata(req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAfter, err := parseTimestamp("created_after", req.CreatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	createdBefore, err := parseTimestamp("created_before", req.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	if !slices.Contains(fileSortFields, sortBy) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort field %q", sortBy)
+	}
+	sortDesc, err := keyset.SortDesc(req.SortOrder, true)
 	if err != nil {
 		return nil, err
 	}
 
+	tenant := auth.Tenant(ctx)
+
+	filter := storagedb.CountFilesParams{
+		FolderID:          folderID,
+		TenantID:          tenant,
+		IncludeSubfolders: req.IncludeSubfolders,
+		DocumentType:      documentType,
+		Tags:              normalizeTags(req.Tags),
+		Metadata:          metadata,
+		CreatedAfter:      createdAfter,
+		CreatedBefore:     createdBefore,
+		FileTypes:         lo.Compact(req.FileTypes),
+	}
+	if query := strings.TrimSpace(req.Query); query != "" {
+		query = likeEscaper.Replace(query)
+		filter.Query = &query
+	}
+	if req.MinSize > 0 {
+		filter.MinSize = &req.MinSize
+	}
+	if req.MaxSize > 0 {
+		filter.MaxSize = &req.MaxSize
+	}
+
+	// One more file is fetched to know whether there is a next page
+	params := getFilesParams{
+		FolderID:          filter.FolderID,
+		TenantID:          filter.TenantID,
+		IncludeSubfolders: filter.IncludeSubfolders,
+		DocumentType:      filter.DocumentType,
+		Tags:              filter.Tags,
+		Metadata:          filter.Metadata,
+		Query:             filter.Query,
+		CreatedAfter:      filter.CreatedAfter,
+		CreatedBefore:     filter.CreatedBefore,
+		MinSize:           filter.MinSize,
+		MaxSize:           filter.MaxSize,
+		FileTypes:         filter.FileTypes,
+		SortBy:            sortBy,
+		SortDesc:          sortDesc,
+		Limit:             limit + 1,
+		Offset:            offset,
+	}
+	if req.Cursor != "" {
+		if err := applyFileCursor(&params, req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := getFiles(ctx, f.pool, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(result) > int(limit)
+	if hasNextPage {
+		result = result[:limit]
+	}
+
+	// Counting scans every matching file, so it is skipped for cursors
+	var totalItems int64
+	if req.Cursor == "" && !req.SkipTotal {
+		totalItems, err = f.db.CountFiles(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	redacted := f.redact && !auth.HasScope(ctx, auth.ScopeReadUnredacted)
+
 	files := make([]*storage.File, len(result))
 	for i, file := range result {
-		files[i] = &storage.File{
-			FileKey:   file.ID.String(),
-			FileName:  file.FileName,
-			FileType:  file.FileType,
-			FileSize:  file.FileSize,
-			CreatedAt: file.CreatedAt.Time.UTC().Format(time.RFC3339),
+		files[i] = fileToProto(file)
+
+		// Covers are rendered from the original first page
+		if !redacted {
+			files[i].CoverUrl = f.coverUrl(ctx, file)
+		}
+	}
+
+	pageNumber := max(req.PageNumber, 1)
+	if req.Cursor != "" {
+		pageNumber = 0
+	}
+
+	pagination := &core.Pagination{
+		PageNumber:  pageNumber,
+		PageSize:    int32(len(result)),
+		TotalItems:  int32(totalItems),
+		HasNextPage: hasNextPage,
+	}
+	if hasNextPage {
+		pagination.NextCursor = fileCursor(result[len(result)-1], sortBy, sortDesc)
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileListed,
+		ResourceType: audit.ResourceFile,
+	})
+
+	return &storage.GetFilesResponse{
+		Files:      files,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetFile implements storage.FilesServiceServer.
+func (f *FilesService) GetFile(
+	ctx context.Context,
+	req *storage.GetFileRequest,
+) (*storage.GetFileResponse, error) {
+	id, err := uuid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	fileID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+	tenant := auth.Tenant(ctx)
+
+	file, err := f.db.GetFile(ctx, storagedb.GetFileParams{
+		ID:       fileID,
+		TenantID: tenant,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "file not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := f.progress.FileProgress(ctx, tenant, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fileToProto(file)
+	if !f.redact || auth.HasScope(ctx, auth.ScopeReadUnredacted) {
+		result.CoverUrl = f.coverUrl(ctx, file)
+	}
+
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileViewed,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   req.FileKey,
+	})
+
+	return &storage.GetFileResponse{
+		File:       result,
+		Processing: processingToProto(progress),
+	}, nil
+}
+
+// UpdateFile implements storage.FilesServiceServer.
+func (f *FilesService) UpdateFile(
+	ctx context.Context,
+	req *storage.UpdateFileRequest,
+) (*storage.File, error) {
+	id, err := uuid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	params := storagedb.UpdateFileParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: auth.Tenant(ctx),
+	}
+	if name := strings.TrimSpace(req.FileName); name != "" {
+		if strings.ContainsAny(name, "/\\") {
+			return nil, status.Errorf(codes.InvalidArgument, "file name cannot contain slashes")
+		}
+		params.FileName = &name
+	}
+	if title := strings.TrimSpace(req.DisplayTitle); title != "" {
+		params.DisplayTitle = &title
+	}
+	if req.DocumentType != "" {
+		params.DocumentType = &req.DocumentType
+	}
+	if req.Language != "" {
+		params.Language = &req.Language
+	}
+
+	var updated storagedb.StorageFile
+	err = f.update(ctx, func(qtx *storagedb.Queries) ([]storagedb.StorageFile, error) {
+		file, err := qtx.UpdateFile(ctx, params)
+		if err != nil {
+			return nil, err
 		}
+		updated = file
+		return []storagedb.StorageFile{file}, nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "file not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return fileToProto(updated), nil
+}
+
+// DeleteFiles implements storage.FilesServiceServer.
+func (f *FilesService) DeleteFiles(
+	ctx context.Context,
+	req *storage.DeleteFilesRequest,
+) (*emptypb.Empty, error) {
+	tenant := auth.Tenant(ctx)
+
+	// Files are moved to the trash, the purger deletes them after the
+	// retention period
+	err := f.transaction(ctx, func(qtx *storagedb.Queries) ([]audit.Entry, error) {
+		deleted, err := qtx.TrashFilesByIDs(ctx, storagedb.TrashFilesByIDsParams{
+			Ids:      fileIDs(req.FileKeys),
+			TenantID: tenant,
+		})
+		if err != nil || len(deleted) == 0 {
+			return nil, err
+		}
+
+		// The OCR domain hides the pages of trashed files
+		if err := createFilesTrashedEvent(ctx, qtx, tenant, uuidStrings(deleted)); err != nil {
+			return nil, err
+		}
+
+		return fileEntries(audit.ActionFileDeleted, deleted), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RestoreFiles implements storage.FilesServiceServer.
+func (f *FilesService) RestoreFiles(
+	ctx context.Context,
+	req *storage.RestoreFilesRequest,
+) (*storage.RestoreFilesResponse, error) {
+	tenant := auth.Tenant(ctx)
+
+	var restored []pgtype.UUID
+	err := f.transaction(ctx, func(qtx *storagedb.Queries) ([]audit.Entry, error) {
+		var err error
+		restored, err = qtx.RestoreFilesByIDs(ctx, storagedb.RestoreFilesByIDsParams{
+			Ids:      fileIDs(req.FileKeys),
+			TenantID: tenant,
+		})
+		if err != nil || len(restored) == 0 {
+			return nil, err
+		}
+
+		if err := createFilesRestoredEvent(ctx, qtx, tenant, uuidStrings(restored)); err != nil {
+			return nil, err
+		}
+
+		return fileEntries(audit.ActionFileRestored, restored), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.RestoreFilesResponse{
+		FileKeys: uuidStrings(restored),
+	}, nil
+}
+
+// ListTrash implements storage.FilesServiceServer.
+func (f *FilesService) ListTrash(
+	ctx context.Context,
+	req *storage.ListTrashRequest,
+) (*storage.ListTrashResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := max(limit*(req.PageNumber-1), 0)
+
+	result, err := f.db.GetTrashedFiles(ctx, storagedb.GetTrashedFilesParams{
+		TenantID: auth.Tenant(ctx),
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*storage.File, len(result))
+	for i, row := range result {
+		files[i] = fileToProto(row.StorageFile)
 	}
 
 	var totalItems int32
@@ -83,26 +423,209 @@ func (f *FilesService) GetFiles(
 		HasNextPage: int32(pageNumber*limit) < totalItems,
 	}
 
-	return &storage.GetFilesResponse{
+	f.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileListed,
+		ResourceType: audit.ResourceFile,
+	})
+
+	return &storage.ListTrashResponse{
 		Files:      files,
 		Pagination: pagination,
 	}, nil
 }
 
-// DeleteFiles implements storage.FilesServiceServer.
-func (f *FilesService) DeleteFiles(
+// MoveFiles implements storage.FilesServiceServer.
+func (f *FilesService) MoveFiles(
 	ctx context.Context,
-	req *storage.DeleteFilesRequest,
+	req *storage.MoveFilesRequest,
 ) (*emptypb.Empty, error) {
-	tx, err := f.pool.Begin(ctx)
+	folderID, err := parseFolderID(req.FolderId)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant := auth.Tenant(ctx)
+
+	if folderID.Valid {
+		_, err := f.db.GetFolder(ctx, storagedb.GetFolderParams{
+			ID:       folderID,
+			TenantID: tenant,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "folder not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = f.update(ctx, func(qtx *storagedb.Queries) ([]storagedb.StorageFile, error) {
+		return qtx.MoveFilesToFolder(ctx, storagedb.MoveFilesToFolderParams{
+			FolderID: folderID,
+			Ids:      fileIDs(req.FileKeys),
+			TenantID: tenant,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// TagFiles implements storage.FilesServiceServer.
+func (f *FilesService) TagFiles(
+	ctx context.Context,
+	req *storage.TagFilesRequest,
+) (*emptypb.Empty, error) {
+	add := normalizeTags(req.Add)
+	remove := normalizeTags(req.Remove)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no tags to add or remove")
+	}
+
+	err := f.update(ctx, func(qtx *storagedb.Queries) ([]storagedb.StorageFile, error) {
+		return qtx.UpdateFileTags(ctx, storagedb.UpdateFileTagsParams{
+			Add:      add,
+			Remove:   remove,
+			Ids:      fileIDs(req.FileKeys),
+			TenantID: auth.Tenant(ctx),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateFileMetadata implements storage.FilesServiceServer.
+func (f *FilesService) UpdateFileMetadata(
+	ctx context.Context,
+	req *storage.UpdateFileMetadataRequest,
+) (*storage.File, error) {
+	id, err := uuid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	set, err := marshalMetadata(req.Set)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated storagedb.StorageFile
+	err = f.update(ctx, func(qtx *storagedb.Queries) ([]storagedb.StorageFile, error) {
+		file, err := qtx.UpdateFileMetadata(ctx, storagedb.UpdateFileMetadataParams{
+			Set:    set,
+			Remove: lo.Ternary(req.Remove == nil, []string{}, req.Remove),
+			ID: pgtype.UUID{
+				Bytes: id,
+				Valid: true,
+			},
+			TenantID: auth.Tenant(ctx),
+		})
+		if err != nil {
+			return nil, err
+		}
+		updated = file
+		return []storagedb.StorageFile{file}, nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "file not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return fileToProto(updated), nil
+}
+
+// update runs a files update in a transaction and emits a FileUpdatedEvent
+// for every updated file.
+func (f *FilesService) update(
+	ctx context.Context,
+	fn func(qtx *storagedb.Queries) ([]storagedb.StorageFile, error),
+) error {
+	return f.transaction(ctx, func(qtx *storagedb.Queries) ([]audit.Entry, error) {
+		updated, err := fn(qtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := createFileUpdatedEvents(ctx, qtx, updated); err != nil {
+			return nil, err
+		}
+
+		return fileEntries(audit.ActionFileUpdated, lo.Map(updated, func(file storagedb.StorageFile, _ int) pgtype.UUID {
+			return file.ID
+		})), nil
+	})
+}
+
+// transaction runs fn in a transaction and records the audit entries it
+// returns in the same transaction, which is committed when both succeed.
+func (f *FilesService) transaction(
+	ctx context.Context,
+	fn func(qtx *storagedb.Queries) ([]audit.Entry, error),
+) error {
+	tx, err := f.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
 	defer tx.Rollback(ctx)
 
-	qtx := f.db.WithTx(tx)
+	entries, err := fn(f.db.WithTx(tx))
+	if err != nil {
+		return err
+	}
+
+	if err := f.audit.RecordTx(ctx, tx, entries...); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// fileEntries builds an audit entry for every file.
+func fileEntries(action string, ids []pgtype.UUID) []audit.Entry {
+	return lo.Map(ids, func(id pgtype.UUID, _ int) audit.Entry {
+		return audit.Entry{
+			Action:       action,
+			ResourceType: audit.ResourceFile,
+			ResourceID:   id.String(),
+		}
+	})
+}
+
+// uuidStrings formats file IDs as the UUID strings events carry.
+func uuidStrings(ids []pgtype.UUID) []string {
+	return lo.Map(ids, func(id pgtype.UUID, _ int) string {
+		return id.String()
+	})
+}
 
-	ids := lo.Map(req.FileKeys, func(key string, _ int) pgtype.UUID {
+// coverUrl presigns the cover of a file, it is empty when presigning fails.
+func (f *FilesService) coverUrl(ctx context.Context, file storagedb.StorageFile) string {
+	coverKey := stg.FileCoverKey(file.TenantID, ulid.ULID(file.ID.Bytes).String())
+	coverUrl, err := f.presign.PresignGetObject(ctx, &s3.GetObjectInput{
+		Key:    &coverKey,
+		Bucket: aws.String(stg.BUCKET_NAME),
+	})
+	if err != nil {
+		return ""
+	}
+	return coverUrl.URL
+}
+
+// Register implements service.Service.
+func (f *FilesService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	storage.RegisterFilesServiceHandlerServer(ctx, mux, f)
+}
+
+// fileIDs parses file keys into database ids, skipping invalid keys.
+func fileIDs(keys []string) []pgtype.UUID {
+	ids := lo.Map(keys, func(key string, _ int) pgtype.UUID {
 		id, err := uuid.Parse(key)
 		if err != nil {
 			return pgtype.UUID{
@@ -116,48 +639,193 @@ func (f *FilesService) DeleteFiles(
 		}
 	})
 
-	ids = lo.Filter(ids, func(id pgtype.UUID, _ int) bool {
+	return lo.Filter(ids, func(id pgtype.UUID, _ int) bool {
 		return id.Valid
 	})
+}
+
+func fileToProto(file storagedb.StorageFile) *storage.File {
+	result := &storage.File{
+		FileKey:   file.ID.String(),
+		FileName:  file.FileName,
+		FileType:  file.FileType,
+		FileSize:  file.FileSize,
+		CreatedAt: file.CreatedAt.Time.UTC().Format(time.RFC3339),
+		Tags:      file.Tags,
+		Metadata:  unmarshalMetadata(file.Metadata),
+	}
+	if file.DocumentType != nil {
+		result.DocumentType = *file.DocumentType
+	}
+	if file.Language != nil {
+		result.Language = *file.Language
+	}
+	if file.DisplayTitle != nil {
+		result.DisplayTitle = *file.DisplayTitle
+	}
+	if file.ContentHash != nil {
+		result.ContentHash = *file.ContentHash
+	}
+	if file.DeletedAt.Valid {
+		result.DeletedAt = file.DeletedAt.Time.UTC().Format(time.RFC3339)
+	}
+	if file.FolderID.Valid {
+		result.FolderId = file.FolderID.String()
+	}
+	return result
+}
 
-	err = qtx.DeleteFilesByIDs(ctx, ids)
+func processingToProto(progress processing.Progress) *storage.FileProcessing {
+	result := &storage.FileProcessing{
+		PageCount:      progress.PageCount,
+		ProcessedPages: progress.ProcessedPages,
+		FailedPages:    progress.FailedPages,
+	}
+	switch {
+	case progress.PageCount == 0:
+		result.Status = "pending"
+	case progress.ProcessedPages+progress.FailedPages < progress.PageCount:
+		result.Status = "processing"
+	case progress.FailedPages > 0:
+		result.Status = "failed"
+	default:
+		result.Status = "completed"
+	}
+	return result
+}
+
+// parseFolderID parses a folder id, empty ids are NULL.
+func parseFolderID(value string) (pgtype.UUID, error) {
+	if value == "" {
+		return pgtype.UUID{}, nil
+	}
+
+	id, err := uuid.Parse(value)
 	if err != nil {
-		return nil, err
+		return pgtype.UUID{}, status.Errorf(codes.InvalidArgument, "invalid folder id: %v", err)
 	}
 
-	event := events.NewFilesDeletedEvent(
-		&storage.FilesDeletedEventData{
-			FileKeys: req.FileKeys,
-		},
-	)
+	return pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}, nil
+}
+
+var fileSortFields = []string{"created_at", "file_name", "file_size"}
+
+// likeEscaper escapes the wildcards of ILIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
 
-	eventId := event.Id
-	eventType := event.Type()
-	payload, err := protojson.Marshal(event.Data())
+// filePosition is the keyset position of the last file of a page.
+type filePosition struct {
+	SortBy string `json:"sort_by"`
+	Desc   bool   `json:"desc"`
+	Value  string `json:"value"`
+	ID     string `json:"id"`
+}
+
+func fileCursor(file storagedb.StorageFile, sortBy string, desc bool) string {
+	position := filePosition{
+		SortBy: sortBy,
+		Desc:   desc,
+		ID:     file.ID.String(),
+	}
+	switch sortBy {
+	case "created_at":
+		position.Value = file.CreatedAt.Time.Format(time.RFC3339Nano)
+	case "file_name":
+		position.Value = file.FileName
+	case "file_size":
+		position.Value = strconv.FormatInt(file.FileSize, 10)
+	}
+	return keyset.EncodeCursor(position)
+}
+
+// applyFileCursor continues params after the position of cursor, which must
+// have been returned for the same sort.
+func applyFileCursor(params *getFilesParams, cursor string) error {
+	var position filePosition
+	if err := keyset.DecodeCursor(cursor, &position); err != nil {
+		return err
+	}
+	if position.SortBy != params.SortBy || position.Desc != params.SortDesc {
+		return status.Errorf(codes.InvalidArgument, "cursor does not match the sort order")
+	}
+
+	id, err := uuid.Parse(position.ID)
 	if err != nil {
-		return nil, err
+		return status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+	params.CursorID = pgtype.UUID{
+		Bytes: id,
+		Valid: true,
 	}
 
-	err = qtx.CreateOutboxEvent(ctx, storagedb.CreateOutboxEventParams{
-		EventID: pgtype.UUID{
-			Bytes: eventId,
+	switch position.SortBy {
+	case "created_at":
+		createdAt, err := time.Parse(time.RFC3339Nano, position.Value)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		params.CursorCreatedAt = pgtype.Timestamptz{
+			Time:  createdAt,
 			Valid: true,
-		},
-		EventType: eventType,
-		Payload:   payload,
-	})
+		}
+	case "file_name":
+		params.CursorFileName = &position.Value
+	case "file_size":
+		size, err := strconv.ParseInt(position.Value, 10, 64)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		params.CursorFileSize = &size
+	}
+
+	params.Offset = 0
+	return nil
+}
+
+// parseTimestamp parses an RFC3339 timestamp, empty values are NULL.
+func parseTimestamp(field, value string) (pgtype.Timestamptz, error) {
+	if value == "" {
+		return pgtype.Timestamptz{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
 	if err != nil {
-		return nil, err
+		return pgtype.Timestamptz{}, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return nil, err
+	return pgtype.Timestamptz{
+		Time:  t,
+		Valid: true,
+	}, nil
+}
+
+// normalizeTags trims and lowercases tags, dropping empty and repeated ones.
+// The result is never nil so it can be compared against tag columns.
+func normalizeTags(tags []string) []string {
+	result := []string{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
 	}
+	return result
+}
 
-	return &emptypb.Empty{}, nil
+func marshalMetadata(metadata map[string]string) ([]byte, error) {
+	if metadata == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(metadata)
 }
 
-// Register implements service.Service.
-func (f *FilesService) Register(ctx context.Context, mux *runtime.ServeMux) {
-	storage.RegisterFilesServiceHandlerServer(ctx, mux, f)
+func unmarshalMetadata(data []byte) map[string]string {
+	metadata := map[string]string{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil
+	}
+	return metadata
 }
@@ -0,0 +1,352 @@
+package storage
+
+import (
+	"backend/gen/storage"
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/service"
+	storagedb "backend/internal/storage/db"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FoldersService struct {
+	storage.UnimplementedFoldersServiceServer
+	db    *storagedb.Queries
+	pool  *pgxpool.Pool
+	audit audit.Recorder
+}
+
+var _ storage.FoldersServiceServer = (*FoldersService)(nil)
+var _ service.Service = (*FoldersService)(nil)
+
+func NewFoldersService(
+	db *storagedb.Queries,
+	pool *pgxpool.Pool,
+	recorder audit.Recorder,
+) *FoldersService {
+	return &FoldersService{
+		db:    db,
+		pool:  pool,
+		audit: recorder,
+	}
+}
+
+// CreateFolder implements storage.FoldersServiceServer.
+func (s *FoldersService) CreateFolder(
+	ctx context.Context,
+	req *storage.CreateFolderRequest,
+) (*storage.Folder, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	parentID, err := parseFolderID(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant := auth.Tenant(ctx)
+
+	if err := s.checkFolder(ctx, parentID, tenant); err != nil {
+		return nil, err
+	}
+
+	id := ulid.MustNew(
+		ulid.Timestamp(time.Now()),
+		ulid.DefaultEntropy(),
+	)
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	folder, err := s.db.WithTx(tx).CreateFolder(ctx, storagedb.CreateFolderParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: tenant,
+		ParentID: parentID,
+		Name:     name,
+	})
+	if isUniqueViolation(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "folder %q already exists", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.audit.RecordTx(ctx, tx, audit.Entry{
+		Action:       audit.ActionFolderCreated,
+		ResourceType: audit.ResourceFolder,
+		ResourceID:   folder.ID.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return folderToProto(folder), nil
+}
+
+// GetFolders implements storage.FoldersServiceServer.
+func (s *FoldersService) GetFolders(
+	ctx context.Context,
+	req *storage.GetFoldersRequest,
+) (*storage.GetFoldersResponse, error) {
+	parentID, err := parseFolderID(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.GetFolders(ctx, storagedb.GetFoldersParams{
+		TenantID: auth.Tenant(ctx),
+		ParentID: parentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	folders := make([]*storage.Folder, len(result))
+	for i, folder := range result {
+		folders[i] = folderToProto(folder)
+	}
+
+	return &storage.GetFoldersResponse{
+		Folders: folders,
+	}, nil
+}
+
+// GetFolder implements storage.FoldersServiceServer.
+func (s *FoldersService) GetFolder(
+	ctx context.Context,
+	req *storage.GetFolderRequest,
+) (*storage.Folder, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder id: %v", err)
+	}
+
+	folder, err := s.db.GetFolder(ctx, storagedb.GetFolderParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: auth.Tenant(ctx),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "folder not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return folderToProto(folder), nil
+}
+
+// UpdateFolder implements storage.FoldersServiceServer.
+func (s *FoldersService) UpdateFolder(
+	ctx context.Context,
+	req *storage.UpdateFolderRequest,
+) (*storage.Folder, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder id: %v", err)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	parentID, err := parseFolderID(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant := auth.Tenant(ctx)
+	folderID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+
+	if err := s.checkFolder(ctx, parentID, tenant); err != nil {
+		return nil, err
+	}
+
+	// A folder cannot be moved into itself or one of its subfolders
+	if parentID.Valid {
+		cycle, err := s.db.FolderHasAncestor(ctx, storagedb.FolderHasAncestorParams{
+			FolderID:   parentID,
+			AncestorID: folderID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if cycle {
+			return nil, status.Errorf(codes.InvalidArgument, "folder cannot be moved into itself")
+		}
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	folder, err := s.db.WithTx(tx).UpdateFolder(ctx, storagedb.UpdateFolderParams{
+		ID:       folderID,
+		Name:     name,
+		ParentID: parentID,
+		TenantID: tenant,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "folder not found")
+	}
+	if isUniqueViolation(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "folder %q already exists", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.audit.RecordTx(ctx, tx, audit.Entry{
+		Action:       audit.ActionFolderUpdated,
+		ResourceType: audit.ResourceFolder,
+		ResourceID:   folder.ID.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return folderToProto(folder), nil
+}
+
+// DeleteFolder implements storage.FoldersServiceServer.
+func (s *FoldersService) DeleteFolder(
+	ctx context.Context,
+	req *storage.DeleteFolderRequest,
+) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder id: %v", err)
+	}
+
+	tenant := auth.Tenant(ctx)
+	folderID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := s.db.WithTx(tx)
+
+	// Files of the folder and its subfolders are moved to the root
+	moved, err := qtx.MoveFolderFilesToRoot(ctx, storagedb.MoveFolderFilesToRootParams{
+		FolderID: folderID,
+		TenantID: tenant,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := createFileUpdatedEvents(ctx, qtx, moved); err != nil {
+		return nil, err
+	}
+
+	deleted, err := qtx.DeleteFolder(ctx, storagedb.DeleteFolderParams{
+		ID:       folderID,
+		TenantID: tenant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, status.Errorf(codes.NotFound, "folder not found")
+	}
+
+	if err := s.audit.RecordTx(ctx, tx, audit.Entry{
+		Action:       audit.ActionFolderDeleted,
+		ResourceType: audit.ResourceFolder,
+		ResourceID:   folderID.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkFolder rejects folder ids that do not belong to the tenant. NULL ids
+// are the root.
+func (s *FoldersService) checkFolder(
+	ctx context.Context,
+	id pgtype.UUID,
+	tenant string,
+) error {
+	if !id.Valid {
+		return nil
+	}
+
+	_, err := s.db.GetFolder(ctx, storagedb.GetFolderParams{
+		ID:       id,
+		TenantID: tenant,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "parent folder not found")
+	}
+	return err
+}
+
+// Register implements service.Service.
+func (s *FoldersService) Register(ctx context.Context, mux *runtime.ServeMux) {
+	storage.RegisterFoldersServiceHandlerServer(ctx, mux, s)
+}
+
+func folderToProto(folder storagedb.StorageFolder) *storage.Folder {
+	result := &storage.Folder{
+		Id:        folder.ID.String(),
+		Name:      folder.Name,
+		CreatedAt: folder.CreatedAt.Time.UTC().Format(time.RFC3339),
+		UpdatedAt: folder.UpdatedAt.Time.UTC().Format(time.RFC3339),
+	}
+	if folder.ParentID.Valid {
+		result.ParentId = folder.ParentID.String()
+	}
+	return result
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,302 @@
+package storage
+
+import (
+	"backend/internal/infrastructure/auth"
+	"backend/internal/infrastructure/config"
+	stg "backend/internal/infrastructure/storage"
+	ocrdb "backend/internal/ocr/db"
+	storagedb "backend/internal/storage/db"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+)
+
+// Garbage is a file without a storage.files row that still has objects in
+// the bucket or pages in ocr.file_pages, or a row whose source object is
+// missing.
+type Garbage struct {
+	TenantID string
+	FileKey  string
+	Objects  int
+	Pages    int64
+	// Missing is set for rows without a source object
+	Missing bool
+}
+
+type GarbageReport struct {
+	Files  []Garbage
+	DryRun bool
+}
+
+// GarbageCollector cross-checks the bucket listing and ocr.file_pages
+// against storage.files. Rows without a source object are deleted, and a
+// FilesDeletedEvent is emitted for them and for the files that only have
+// objects or pages left so every domain cleans them up.
+type GarbageCollector struct {
+	pool     *pgxpool.Pool
+	db       *storagedb.Queries
+	ocr      *ocrdb.Queries
+	client   *s3.Client
+	enabled  bool
+	interval time.Duration
+	grace    time.Duration
+	dryRun   bool
+}
+
+func NewGarbageCollector(
+	cfg *config.AppConfig,
+	pool *pgxpool.Pool,
+	db *storagedb.Queries,
+	ocr *ocrdb.Queries,
+	client *s3.Client,
+) *GarbageCollector {
+	interval := cfg.GC.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	grace := cfg.GC.GracePeriod
+	if grace <= 0 {
+		grace = 72 * time.Hour
+	}
+
+	return &GarbageCollector{
+		pool:     pool,
+		db:       db,
+		ocr:      ocr,
+		client:   client,
+		enabled:  cfg.GC.Enabled,
+		interval: interval,
+		grace:    grace,
+		dryRun:   cfg.GC.DryRun,
+	}
+}
+
+// Start runs the collector periodically when it is enabled.
+func (g *GarbageCollector) Start(
+	ctx context.Context,
+) error {
+	if !g.enabled {
+		return nil
+	}
+
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			report, err := g.Collect(ctx, g.dryRun)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to collect garbage", "error", err)
+				continue
+			}
+			for _, file := range report.Files {
+				slog.InfoContext(ctx, "Garbage file",
+					"tenant_id", file.TenantID,
+					"file_key", file.FileKey,
+					"objects", file.Objects,
+					"pages", file.Pages,
+					"missing", file.Missing,
+					"dry_run", report.DryRun,
+				)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Collect reports the files whose newest object and page are older than the
+// grace period and have no storage.files row, and the rows created before
+// the grace period without a source object. Unless dryRun is set, they are
+// then deleted.
+func (g *GarbageCollector) Collect(
+	ctx context.Context,
+	dryRun bool,
+) (*GarbageReport, error) {
+	before := time.Now().Add(-g.grace)
+
+	candidates := map[uuid.UUID]*Garbage{}
+	recent := map[uuid.UUID]bool{}
+	sources := map[uuid.UUID]bool{}
+
+	candidate := func(id uuid.UUID, tenant string) *Garbage {
+		garbage, ok := candidates[id]
+		if !ok {
+			garbage = &Garbage{TenantID: tenant, FileKey: id.String()}
+			candidates[id] = garbage
+		}
+		return garbage
+	}
+
+	// Rows are read last so files created while listing are not collected
+	paginator := s3.NewListObjectsV2Paginator(g.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(stg.BUCKET_NAME),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		for _, object := range page.Contents {
+			key, ok := parseObjectKey(aws.ToString(object.Key))
+			if !ok {
+				continue
+			}
+			candidate(key.file, key.tenant).Objects++
+			if key.source {
+				sources[key.file] = true
+			}
+			if aws.ToTime(object.LastModified).After(before) {
+				recent[key.file] = true
+			}
+		}
+	}
+
+	after := pgtype.UUID{Valid: true}
+	for {
+		rows, err := g.ocr.GetPageFiles(ctx, ocrdb.GetPageFilesParams{
+			After: after,
+			Limit: 1000,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			id := uuid.UUID(row.FileID.Bytes)
+			candidate(id, row.TenantID).Pages = row.Pages
+			if row.LastCreatedAt.Time.After(before) {
+				recent[id] = true
+			}
+		}
+
+		if len(rows) < 1000 {
+			break
+		}
+		after = rows[len(rows)-1].FileID
+	}
+
+	after = pgtype.UUID{Valid: true}
+	for {
+		rows, err := g.db.GetFileKeys(ctx, storagedb.GetFileKeysParams{
+			After: after,
+			Limit: 1000,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			id := uuid.UUID(row.ID.Bytes)
+			if sources[id] {
+				delete(candidates, id)
+				continue
+			}
+			candidate(id, row.TenantID).Missing = true
+			if row.CreatedAt.Time.After(before) {
+				recent[id] = true
+			}
+		}
+
+		if len(rows) < 1000 {
+			break
+		}
+		after = rows[len(rows)-1].ID
+	}
+
+	report := &GarbageReport{DryRun: dryRun}
+	for id, garbage := range candidates {
+		if !recent[id] {
+			report.Files = append(report.Files, *garbage)
+		}
+	}
+
+	if dryRun || len(report.Files) == 0 {
+		return report, nil
+	}
+
+	if err := g.delete(ctx, report.Files); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (g *GarbageCollector) delete(ctx context.Context, files []Garbage) error {
+	tx, err := g.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := g.db.WithTx(tx)
+
+	missing := lo.FilterMap(files, func(file Garbage, _ int) (pgtype.UUID, bool) {
+		return pgtype.UUID{Bytes: uuid.MustParse(file.FileKey), Valid: true}, file.Missing
+	})
+	if len(missing) > 0 {
+		if _, err := qtx.DeleteFilesByIDs(ctx, missing); err != nil {
+			return err
+		}
+	}
+
+	byTenant := lo.GroupBy(files, func(file Garbage) string {
+		return file.TenantID
+	})
+
+	for tenant, files := range byTenant {
+		for _, chunk := range lo.Chunk(files, 100) {
+			fileKeys := lo.Map(chunk, func(file Garbage, _ int) string {
+				return file.FileKey
+			})
+			if err := createFilesDeletedEvent(ctx, qtx, tenant, fileKeys); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// objectKey is the file an object key belongs to, source is set for the
+// uploaded file and unset for the images rendered from it.
+type objectKey struct {
+	tenant string
+	file   uuid.UUID
+	source bool
+}
+
+func parseObjectKey(key string) (objectKey, bool) {
+	result := objectKey{tenant: auth.DefaultTenant, source: true}
+	if rest, ok := strings.CutPrefix(key, "tenants/"); ok {
+		result.tenant, key, ok = strings.Cut(rest, "/")
+		if !ok {
+			return objectKey{}, false
+		}
+	}
+
+	if rest, ok := strings.CutPrefix(key, "images/"); ok {
+		key, _, _ = strings.Cut(rest, "/")
+		result.source = false
+	}
+
+	id, err := ulid.ParseStrict(key)
+	if err != nil {
+		return objectKey{}, false
+	}
+	result.file = uuid.UUID(id)
+
+	return result, true
+}
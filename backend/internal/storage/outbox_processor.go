@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -182,7 +183,177 @@ func (p *OutboxProcessor) event(
 			Id:      event.EventID.Bytes,
 			Payload: data,
 		}, nil
+	case events.STORAGE_FILES_TRASHED_EVENT:
+		data := &storage.FilesTrashedEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.FilesTrashedEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
+	case events.STORAGE_FILES_RESTORED_EVENT:
+		data := &storage.FilesRestoredEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.FilesRestoredEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
+	case events.STORAGE_FILE_UPDATED_EVENT:
+		data := &storage.FileUpdatedEventData{}
+		if err := protojson.Unmarshal(event.Payload, data); err != nil {
+			return nil, err
+		}
+		return &events.FileUpdatedEvent{
+			Id:      event.EventID.Bytes,
+			Payload: data,
+		}, nil
 	}
 
 	return nil, fmt.Errorf("unknown outbox event type: %s", event.EventType)
 }
+
+// createFilesDeletedEvent stores a FilesDeletedEvent for the files of a tenant
+// in the outbox.
+func createFilesDeletedEvent(
+	ctx context.Context,
+	qtx *storagedb.Queries,
+	tenant string,
+	fileKeys []string,
+) error {
+	event := events.NewFilesDeletedEvent(
+		&storage.FilesDeletedEventData{
+			FileKeys: fileKeys,
+			TenantId: tenant,
+		},
+	)
+
+	payload, err := protojson.Marshal(event.Data())
+	if err != nil {
+		return err
+	}
+
+	return qtx.CreateOutboxEvent(ctx, storagedb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: event.Id,
+			Valid: true,
+		},
+		EventType: event.Type(),
+		Payload:   payload,
+	})
+}
+
+// createFilesTrashedEvent stores a FilesTrashedEvent for the files of a tenant
+// in the outbox.
+func createFilesTrashedEvent(
+	ctx context.Context,
+	qtx *storagedb.Queries,
+	tenant string,
+	fileKeys []string,
+) error {
+	event := events.NewFilesTrashedEvent(
+		&storage.FilesTrashedEventData{
+			FileKeys:  fileKeys,
+			TenantId:  tenant,
+			TrashedAt: time.Now().UTC().Format(time.RFC3339Nano),
+		},
+	)
+
+	payload, err := protojson.Marshal(event.Data())
+	if err != nil {
+		return err
+	}
+
+	return qtx.CreateOutboxEvent(ctx, storagedb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: event.Id,
+			Valid: true,
+		},
+		EventType: event.Type(),
+		Payload:   payload,
+	})
+}
+
+// createFilesRestoredEvent stores a FilesRestoredEvent for the files of a
+// tenant in the outbox.
+func createFilesRestoredEvent(
+	ctx context.Context,
+	qtx *storagedb.Queries,
+	tenant string,
+	fileKeys []string,
+) error {
+	event := events.NewFilesRestoredEvent(
+		&storage.FilesRestoredEventData{
+			FileKeys:   fileKeys,
+			TenantId:   tenant,
+			RestoredAt: time.Now().UTC().Format(time.RFC3339Nano),
+		},
+	)
+
+	payload, err := protojson.Marshal(event.Data())
+	if err != nil {
+		return err
+	}
+
+	return qtx.CreateOutboxEvent(ctx, storagedb.CreateOutboxEventParams{
+		EventID: pgtype.UUID{
+			Bytes: event.Id,
+			Valid: true,
+		},
+		EventType: event.Type(),
+		Payload:   payload,
+	})
+}
+
+// createFileUpdatedEvents stores a FileUpdatedEvent for every file in the
+// outbox.
+func createFileUpdatedEvents(
+	ctx context.Context,
+	qtx *storagedb.Queries,
+	files []storagedb.StorageFile,
+) error {
+	for _, file := range files {
+		data := &storage.FileUpdatedEventData{
+			FileKey:  file.ID.String(),
+			TenantId: file.TenantID,
+			FileName: file.FileName,
+			Tags:     file.Tags,
+			Metadata: unmarshalMetadata(file.Metadata),
+		}
+		if file.FolderID.Valid {
+			data.FolderId = file.FolderID.String()
+		}
+		if file.DisplayTitle != nil {
+			data.DisplayTitle = *file.DisplayTitle
+		}
+		if file.DocumentType != nil {
+			data.DocumentType = *file.DocumentType
+		}
+		if file.Language != nil {
+			data.Language = *file.Language
+		}
+
+		event := events.NewFileUpdatedEvent(data)
+
+		payload, err := protojson.Marshal(event.Data())
+		if err != nil {
+			return err
+		}
+
+		err = qtx.CreateOutboxEvent(ctx, storagedb.CreateOutboxEventParams{
+			EventID: pgtype.UUID{
+				Bytes: event.Id,
+				Valid: true,
+			},
+			EventType: event.Type(),
+			Payload:   payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package storage
 
 import (
 	"backend/gen/storage"
+	"backend/internal/infrastructure/audit"
+	"backend/internal/infrastructure/auth"
 	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/processing"
 	"backend/internal/infrastructure/service"
 	stg "backend/internal/infrastructure/storage"
+	storagedb "backend/internal/storage/db"
 	"backend/internal/storage/events"
 	"context"
 	"errors"
@@ -17,7 +21,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -26,7 +34,11 @@ type StorageService struct {
 	presign  *s3.PresignClient
 	client   *s3.Client
 	producer *StorageProducer
+	db       *storagedb.Queries
+	schemas  processing.Schemas
+	audit    audit.Recorder
 	cors     config.CorsConfig
+	tenants  config.TenantsConfig
 }
 
 var _ storage.StorageServiceServer = (*StorageService)(nil)
@@ -36,13 +48,20 @@ func NewStorageService(
 	presign *s3.PresignClient,
 	client *s3.Client,
 	producer *StorageProducer,
+	db *storagedb.Queries,
+	schemas processing.Schemas,
+	recorder audit.Recorder,
 	cfg *config.AppConfig,
 ) *StorageService {
 	return &StorageService{
 		presign:  presign,
 		client:   client,
 		producer: producer,
+		db:       db,
+		schemas:  schemas,
+		audit:    recorder,
 		cors:     cfg.Cors,
+		tenants:  cfg.Tenants,
 	}
 }
 
@@ -51,6 +70,19 @@ func (s *StorageService) GetUploadUrl(
 	ctx context.Context,
 	req *emptypb.Empty,
 ) (*storage.GetUploadUrlResponse, error) {
+	tenant := auth.Tenant(ctx)
+
+	quota := s.tenants.TenantQuota(tenant)
+	if quota.MaxFiles > 0 {
+		usage, err := s.db.GetTenantUsage(ctx, tenant)
+		if err != nil {
+			return nil, err
+		}
+		if usage.Files >= quota.MaxFiles {
+			return nil, status.Errorf(codes.ResourceExhausted, "file quota of %d files exceeded", quota.MaxFiles)
+		}
+	}
+
 	// Generate random object key
 	key := ulid.MustNew(
 		ulid.Timestamp(time.Now()),
@@ -59,7 +91,7 @@ func (s *StorageService) GetUploadUrl(
 
 	// Generate presigned URL
 	result, err := s.presign.PresignPutObject(ctx, &s3.PutObjectInput{
-		Key:    &key,
+		Key:    aws.String(stg.TenantKey(tenant, key)),
 		Bucket: aws.String(stg.BUCKET_NAME),
 	})
 	if err != nil {
@@ -77,18 +109,60 @@ func (s *StorageService) ConfirmFileUpload(
 	ctx context.Context,
 	req *storage.ConfirmFileUploadRequest,
 ) (*emptypb.Empty, error) {
+	id, err := ulid.Parse(req.FileKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file key: %v", err)
+	}
+
+	tenant := auth.Tenant(ctx)
+
+	if req.ExtractionSchemaId != "" {
+		schemaID, err := uuid.Parse(req.ExtractionSchemaId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid extraction schema id: %v", err)
+		}
+
+		exists, err := s.schemas.SchemaExists(ctx, tenant, pgtype.UUID{
+			Bytes: schemaID,
+			Valid: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "extraction schema not found")
+		}
+	}
+
+	quota := s.tenants.TenantQuota(tenant)
+	if quota.MaxBytes > 0 {
+		if err := s.checkStorageQuota(ctx, tenant, req.FileKey, quota.MaxBytes); err != nil {
+			return nil, err
+		}
+	}
+
 	event := events.NewFileUploadedEvent(
 		&storage.FileUploadedEventData{
-			FileName: req.FileName,
-			FileKey:  req.FileKey,
+			FileName:           req.FileName,
+			FileKey:            req.FileKey,
+			ExtractionSchemaId: req.ExtractionSchemaId,
+			TenantId:           tenant,
 		},
 	)
 
-	err := s.producer.Publish(ctx, event)
+	err = s.producer.Publish(ctx, event)
 	if err != nil {
 		return nil, fmt.Errorf("failed to publish file uploaded event: %w", err)
 	}
 
+	// The file row is created by the file uploaded consumer, there is no
+	// transaction here to record the entry in
+	s.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileUploaded,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   uuid.UUID(id).String(),
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -102,7 +176,23 @@ func (s *StorageService) GetFileUrl(
 		return nil, fmt.Errorf("invalid file key: %w", err)
 	}
 
-	fileKey := ulid.ULID(id).String()
+	tenant := auth.Tenant(ctx)
+
+	_, err = s.db.GetFile(ctx, storagedb.GetFileParams{
+		ID: pgtype.UUID{
+			Bytes: id,
+			Valid: true,
+		},
+		TenantID: tenant,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "file not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey := stg.TenantKey(tenant, ulid.ULID(id).String())
 	result, err := s.presign.PresignGetObject(ctx, &s3.GetObjectInput{
 		Key:    &fileKey,
 		Bucket: aws.String(stg.BUCKET_NAME),
@@ -111,11 +201,58 @@ func (s *StorageService) GetFileUrl(
 		return nil, err
 	}
 
+	s.audit.Record(ctx, audit.Entry{
+		Action:       audit.ActionFileDownloaded,
+		ResourceType: audit.ResourceFile,
+		ResourceID:   id.String(),
+	})
+
 	return &storage.GetFileUrlResponse{
 		FileUrl: result.URL,
 	}, nil
 }
 
+// checkStorageQuota rejects an uploaded object that would take the tenant
+// over its storage quota, and deletes it.
+func (s *StorageService) checkStorageQuota(
+	ctx context.Context,
+	tenant string,
+	fileKey string,
+	maxBytes int64,
+) error {
+	key := stg.TenantKey(tenant, fileKey)
+
+	head, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Key:    &key,
+		Bucket: aws.String(stg.BUCKET_NAME),
+	})
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return status.Errorf(codes.NotFound, "file not uploaded")
+	}
+	if err != nil {
+		return err
+	}
+
+	usage, err := s.db.GetTenantUsage(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	if usage.Bytes+aws.ToInt64(head.ContentLength) <= maxBytes {
+		return nil
+	}
+
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Key:    &key,
+		Bucket: aws.String(stg.BUCKET_NAME),
+	}); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete file over quota", "file_key", fileKey, "error", err)
+	}
+
+	return status.Errorf(codes.ResourceExhausted, "storage quota of %d bytes exceeded", maxBytes)
+}
+
 // Register implements service.Service.
 func (s *StorageService) Register(ctx context.Context, mux *runtime.ServeMux) {
 	storage.RegisterStorageServiceHandlerServer(ctx, mux, s)
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"backend/internal/infrastructure/config"
+	storagedb "backend/internal/storage/db"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TrashPurger deletes files that have been in the trash for longer than the
+// retention period and emits a FilesDeletedEvent per tenant through the
+// outbox.
+type TrashPurger struct {
+	pool      *pgxpool.Pool
+	db        *storagedb.Queries
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewTrashPurger(
+	cfg *config.AppConfig,
+	pool *pgxpool.Pool,
+	db *storagedb.Queries,
+) *TrashPurger {
+	retention := cfg.Trash.Retention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+	interval := cfg.Trash.PurgeInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	return &TrashPurger{
+		pool:      pool,
+		db:        db,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+func (p *TrashPurger) Start(
+	ctx context.Context,
+) error {
+	p.purge(ctx)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.purge(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// purge deletes expired files in batches until none are left.
+func (p *TrashPurger) purge(ctx context.Context) {
+	for {
+		purged, err := p.purgeBatch(ctx, 100)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to purge trash", "error", err)
+			return
+		}
+		if purged < 100 {
+			return
+		}
+	}
+}
+
+func (p *TrashPurger) purgeBatch(ctx context.Context, limit int32) (int, error) {
+	tracer := otel.Tracer("storage_trash_purger")
+	ctx, span := tracer.Start(
+		ctx,
+		"TrashPurger.purgeBatch",
+		trace.WithSpanKind(trace.SpanKindInternal),
+	)
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := p.db.WithTx(tx)
+
+	purged, err := qtx.PurgeTrashedFiles(ctx, storagedb.PurgeTrashedFilesParams{
+		DeletedBefore: pgtype.Timestamptz{
+			Time:  time.Now().Add(-p.retention),
+			Valid: true,
+		},
+		Limit: limit,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int("trash.purged_count", len(purged)))
+
+	if len(purged) == 0 {
+		return 0, nil
+	}
+
+	byTenant := lo.GroupBy(purged, func(row storagedb.PurgeTrashedFilesRow) string {
+		return row.TenantID
+	})
+
+	for tenant, rows := range byTenant {
+		fileKeys := lo.Map(rows, func(row storagedb.PurgeTrashedFilesRow, _ int) string {
+			return row.ID.String()
+		})
+		if err := createFilesDeletedEvent(ctx, qtx, tenant, fileKeys); err != nil {
+			span.RecordError(err)
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "Purged trashed files", "count", len(purged))
+
+	return len(purged), nil
+}
@@ -0,0 +1 @@
+DROP SCHEMA IF EXISTS audit;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Create audit schema
+CREATE SCHEMA IF NOT EXISTS audit;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS events_truncate_trigger ON audit.events;
+DROP TRIGGER IF EXISTS events_update_delete_trigger ON audit.events;
+DROP FUNCTION IF EXISTS audit.reject_events_change();
+DROP TABLE IF EXISTS audit.events;
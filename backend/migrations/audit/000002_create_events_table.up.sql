@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS audit.events (
+    id UUID PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    auth_method TEXT NOT NULL,
+    action TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    trace_id TEXT NOT NULL,
+    client_ip TEXT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_tenant_id_occurred_at
+    ON audit.events (tenant_id, occurred_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_events_tenant_id_resource
+    ON audit.events (tenant_id, resource_type, resource_id);
+
+-- Audit events are append-only
+CREATE OR REPLACE FUNCTION audit.reject_events_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit.events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_update_delete_trigger
+BEFORE UPDATE OR DELETE ON audit.events
+FOR EACH ROW
+EXECUTE FUNCTION audit.reject_events_change();
+
+CREATE TRIGGER events_truncate_trigger
+BEFORE TRUNCATE ON audit.events
+FOR EACH STATEMENT
+EXECUTE FUNCTION audit.reject_events_change();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS outbox_insert_trigger ON audit.outbox;
+DROP FUNCTION IF EXISTS audit.notify_outbox_event();
+DROP TABLE IF EXISTS audit.outbox;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ocr.page_extractions;
+DROP TABLE IF EXISTS ocr.extraction_schemas;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS ocr.extraction_schemas (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    json_schema JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS ocr.page_extractions (
+    page_id UUID NOT NULL REFERENCES ocr.file_pages(id) ON DELETE CASCADE,
+    schema_id UUID NOT NULL REFERENCES ocr.extraction_schemas(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL,
+    data JSONB,
+    error_message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (page_id, schema_id)
+);
+
+CREATE INDEX idx_page_extractions_file_id ON ocr.page_extractions(file_id);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS ocr.llm_usage (
+    id UUID PRIMARY KEY,
+    file_id UUID NOT NULL,
+    page_id UUID,
+    agent TEXT NOT NULL,
+    model TEXT NOT NULL,
+    provider TEXT NOT NULL DEFAULT '',
+    prompt_tokens BIGINT NOT NULL DEFAULT 0,
+    completion_tokens BIGINT NOT NULL DEFAULT 0,
+    cost DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_llm_usage_file_id ON ocr.llm_usage(file_id);
@@ -0,0 +1,3 @@
+ALTER TABLE ocr.file_pages
+    DROP COLUMN IF EXISTS ocr_provider,
+    DROP COLUMN IF EXISTS ocr_model;
@@ -0,0 +1,3 @@
+ALTER TABLE ocr.file_pages
+    ADD COLUMN IF NOT EXISTS ocr_model TEXT,
+    ADD COLUMN IF NOT EXISTS ocr_provider TEXT;
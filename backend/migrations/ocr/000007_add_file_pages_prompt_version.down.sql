@@ -0,0 +1,2 @@
+ALTER TABLE ocr.file_pages
+    DROP COLUMN IF EXISTS prompt_version;
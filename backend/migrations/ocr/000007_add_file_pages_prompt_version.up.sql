@@ -0,0 +1,2 @@
+ALTER TABLE ocr.file_pages
+    ADD COLUMN IF NOT EXISTS prompt_version TEXT;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS ocr.page_translations (
+    page_id UUID NOT NULL REFERENCES ocr.file_pages(id) ON DELETE CASCADE,
+    language TEXT NOT NULL,
+    file_id UUID NOT NULL,
+    text_content TEXT NOT NULL,
+    model TEXT,
+    provider TEXT,
+    prompt_version TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (page_id, language)
+);
+
+CREATE INDEX idx_page_translations_file_id ON ocr.page_translations(file_id);
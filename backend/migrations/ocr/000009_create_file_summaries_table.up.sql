@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ocr.file_summaries (
+    file_id UUID PRIMARY KEY,
+    title TEXT NOT NULL,
+    summary TEXT NOT NULL,
+    page_count INTEGER NOT NULL,
+    model TEXT,
+    prompt_version TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
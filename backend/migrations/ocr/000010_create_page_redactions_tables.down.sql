@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ocr.page_entities;
+DROP TABLE IF EXISTS ocr.page_redactions;
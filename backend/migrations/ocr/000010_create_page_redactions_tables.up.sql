@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS ocr.page_redactions (
+    page_id UUID PRIMARY KEY REFERENCES ocr.file_pages(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL,
+    text_content TEXT NOT NULL,
+    image_key TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_page_redactions_file_id ON ocr.page_redactions(file_id);
+
+CREATE TABLE IF NOT EXISTS ocr.page_entities (
+    id UUID PRIMARY KEY,
+    page_id UUID NOT NULL REFERENCES ocr.file_pages(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL,
+    entity_type TEXT NOT NULL,
+    detector TEXT NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    boxes JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_page_entities_page_id ON ocr.page_entities(page_id);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS ocr.page_layouts (
+    page_id UUID PRIMARY KEY REFERENCES ocr.file_pages(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL,
+    source TEXT NOT NULL,
+    layout BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_page_layouts_file_id ON ocr.page_layouts(file_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ocr.idx_file_pages_content_hash;
+
+ALTER TABLE ocr.file_pages
+    DROP COLUMN IF EXISTS content_hash;
@@ -0,0 +1,4 @@
+ALTER TABLE ocr.file_pages
+    ADD COLUMN IF NOT EXISTS content_hash TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_file_pages_content_hash ON ocr.file_pages(content_hash);
@@ -0,0 +1,12 @@
+ALTER TABLE ocr.extraction_schemas
+    DROP CONSTRAINT IF EXISTS extraction_schemas_tenant_id_name_key,
+    ADD CONSTRAINT extraction_schemas_name_key UNIQUE (name),
+    DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS ocr.idx_file_pages_tenant_id_content_hash;
+CREATE INDEX IF NOT EXISTS idx_file_pages_content_hash ON ocr.file_pages(content_hash);
+
+DROP INDEX IF EXISTS ocr.idx_file_pages_tenant_id_file_id;
+
+ALTER TABLE ocr.file_pages
+    DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,12 @@
+ALTER TABLE ocr.file_pages
+    ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS idx_file_pages_tenant_id_file_id ON ocr.file_pages(tenant_id, file_id);
+
+DROP INDEX IF EXISTS ocr.idx_file_pages_content_hash;
+CREATE INDEX IF NOT EXISTS idx_file_pages_tenant_id_content_hash ON ocr.file_pages(tenant_id, content_hash);
+
+ALTER TABLE ocr.extraction_schemas
+    ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default',
+    DROP CONSTRAINT IF EXISTS extraction_schemas_name_key,
+    ADD CONSTRAINT extraction_schemas_tenant_id_name_key UNIQUE (tenant_id, name);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS ocr.file_states (
+    file_id UUID PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    trashed BOOLEAN NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE ocr.file_states DROP COLUMN IF EXISTS classified_at;
@@ -0,0 +1 @@
+ALTER TABLE ocr.file_states ADD COLUMN IF NOT EXISTS classified_at TIMESTAMPTZ;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS storage.idx_files_document_type;
+
+ALTER TABLE storage.files
+DROP COLUMN IF EXISTS language,
+DROP COLUMN IF EXISTS document_type;
@@ -0,0 +1,5 @@
+ALTER TABLE storage.files
+ADD COLUMN IF NOT EXISTS document_type TEXT,
+ADD COLUMN IF NOT EXISTS language TEXT;
+
+CREATE INDEX idx_files_document_type ON storage.files(document_type);
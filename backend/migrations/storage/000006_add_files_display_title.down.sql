@@ -0,0 +1,2 @@
+ALTER TABLE storage.files
+DROP COLUMN IF EXISTS display_title;
@@ -0,0 +1,2 @@
+ALTER TABLE storage.files
+ADD COLUMN IF NOT EXISTS display_title TEXT;
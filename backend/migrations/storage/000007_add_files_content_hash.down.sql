@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS storage.files_content_hash_idx;
+
+ALTER TABLE storage.files
+DROP COLUMN IF EXISTS content_hash;
@@ -0,0 +1,4 @@
+ALTER TABLE storage.files
+ADD COLUMN IF NOT EXISTS content_hash TEXT;
+
+CREATE INDEX IF NOT EXISTS files_content_hash_idx ON storage.files (content_hash);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS storage.files_tenant_id_content_hash_idx;
+CREATE INDEX IF NOT EXISTS files_content_hash_idx ON storage.files (content_hash);
+
+DROP INDEX IF EXISTS storage.files_tenant_id_created_at_idx;
+
+ALTER TABLE storage.files
+DROP COLUMN IF EXISTS tenant_id;
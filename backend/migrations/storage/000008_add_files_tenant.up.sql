@@ -0,0 +1,7 @@
+ALTER TABLE storage.files
+ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS files_tenant_id_created_at_idx ON storage.files (tenant_id, created_at DESC);
+
+DROP INDEX IF EXISTS storage.files_content_hash_idx;
+CREATE INDEX IF NOT EXISTS files_tenant_id_content_hash_idx ON storage.files (tenant_id, content_hash);
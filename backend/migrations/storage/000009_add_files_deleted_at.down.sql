@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS storage.files_deleted_at_idx;
+
+ALTER TABLE storage.files
+DROP COLUMN IF EXISTS deleted_at;
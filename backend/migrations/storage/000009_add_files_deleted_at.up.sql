@@ -0,0 +1,5 @@
+ALTER TABLE storage.files
+ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS files_deleted_at_idx ON storage.files (deleted_at)
+WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS storage.files_metadata_idx;
+DROP INDEX IF EXISTS storage.files_tags_idx;
+DROP INDEX IF EXISTS storage.files_folder_id_idx;
+
+ALTER TABLE storage.files
+DROP COLUMN IF EXISTS metadata,
+DROP COLUMN IF EXISTS tags,
+DROP COLUMN IF EXISTS folder_id;
+
+DROP TABLE IF EXISTS storage.folders;
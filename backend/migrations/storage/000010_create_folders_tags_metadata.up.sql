@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS storage.folders (
+    id uuid PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    parent_id uuid REFERENCES storage.folders (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS folders_tenant_id_parent_id_name_idx
+ON storage.folders (tenant_id, parent_id, name) NULLS NOT DISTINCT;
+
+ALTER TABLE storage.files
+ADD COLUMN IF NOT EXISTS folder_id uuid REFERENCES storage.folders (id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS files_folder_id_idx ON storage.files (folder_id);
+CREATE INDEX IF NOT EXISTS files_tags_idx ON storage.files USING GIN (tags);
+CREATE INDEX IF NOT EXISTS files_metadata_idx ON storage.files USING GIN (metadata);
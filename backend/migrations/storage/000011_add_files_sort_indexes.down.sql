@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS storage.files_tenant_id_file_size_idx;
+DROP INDEX IF EXISTS storage.files_tenant_id_file_name_idx;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS files_tenant_id_file_name_idx ON storage.files (tenant_id, file_name, id);
+CREATE INDEX IF NOT EXISTS files_tenant_id_file_size_idx ON storage.files (tenant_id, file_size, id);
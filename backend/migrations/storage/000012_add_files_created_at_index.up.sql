@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS files_tenant_id_created_at_id_idx ON storage.files (tenant_id, created_at, id);
+DROP INDEX IF EXISTS storage.files_tenant_id_created_at_idx;